@@ -54,18 +54,21 @@ func init() {
 				TotalH: 10.4,
 			},
 
-			SensorMount: obj.TrackballSensorMount{
-				ScrewDist:    24,
-				ScrewRTop:    3.1 / 2,
-				ScrewRBottom: 2.8 / 2,
-				ScrewMargin:  1.1,
-				ScrewDepth:   3.7,
+			SensorMount: &obj.TrackballSensorMount{
+				ScrewDist:       24,
+				Screw:           obj.M3Pan(3.7),
+				ScrewWallMargin: 1.1,
 
 				BaseH: 21,
 				BaseD: 1.5,
 
 				SensorClearance: 10,
 				LensHoleR:       4.5,
+
+				PCBWidth:  21,
+				PCBHeight: 24,
+
+				CableChannelW: 4,
 			},
 			SensorDistFromBall: 1.6,
 			SensorAngleY:       -11,
@@ -111,6 +114,24 @@ func renderSwitchSocket() error {
 	return render.RenderSTL(m, renderSettings)
 }
 
+func renderKeycap() error {
+	m, err := obj.Keycap{
+		Stem:          obj.KeycapStemMX,
+		Profile:       obj.KeycapProfileDSA,
+		Unit:          obj.Keycap1u,
+		Height:        7.6,
+		WallThickness: 1.2,
+		Socket:        pterosphera.Switches,
+	}.Render(obj.KeycapRender{
+		Settings: renderSettings,
+	})
+	if err != nil {
+		return err
+	}
+
+	return render.RenderSTL(m, renderSettings)
+}
+
 func renderTrackballSocket() error {
 	// Render the socket
 	m, err := pterosphera.TrackballSocket.Render(obj.TrackballSocketRender{
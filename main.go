@@ -53,16 +53,16 @@ func init() {
 				TotalH: 10.4,
 			},
 
-			SensorMount: obj.TrackballSensorMount{
-				ScrewDist:    24,
-				ScrewRTop:    3.1 / 2,
-				ScrewRBottom: 2.8 / 2,
-				ScrewMargin:  1.1,
-				ScrewDepth:   3.7,
+			SensorMount: &obj.TrackballSensorMount{
+				ScrewDist:       24,
+				Screw:           obj.M3Pan(3.7),
+				ScrewWallMargin: 1.1,
 
 				BaseH: 21,
 				BaseD: 1.5,
 			},
+			SensorDistFromBall: 1.6,
+			SensorAngleY:       -11,
 		},
 	}
 }
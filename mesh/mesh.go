@@ -0,0 +1,280 @@
+// Package mesh renders an sdf.SDF3 to a triangle mesh and indexes it with an rtree, so designs
+// can be checked for collisions or containment - e.g. a switch plate clipping the BTU ring, or a
+// sensor's cable channel exiting inside the socket wall - before they're exported to STL.
+package mesh
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+
+	sdfxrender "github.com/deadsy/sdfx/render"
+	"github.com/deadsy/sdfx/sdf"
+	"github.com/tidwall/rtree"
+)
+
+// Triangle is a single facet of a triangle mesh, independent of any particular export format.
+type Triangle struct {
+	V [3]sdf.V3
+}
+
+// Mesh is a triangle-soup rendering of an sdf.SDF3, with its triangles bulk-loaded into an
+// STR-packed rtree index over each triangle's XY-projected bounding box. github.com/tidwall/rtree
+// only indexes two dimensions, so the index narrows candidates by X/Y overlap and every query
+// checks the Z axis itself against the triangle's own bounds.
+type Mesh struct {
+	// Tris holds every triangle in the mesh, in rendering order.
+	Tris []Triangle
+
+	index    rtree.RTree
+	min, max [3]float64
+}
+
+// New renders s to a triangle mesh via sdfx's marching-cubes renderer, using cellSize cells along
+// the model's longest axis, then bulk-loads the triangles into an STR-packed rtree index.
+func New(s sdf.SDF3, cellSize int) (*Mesh, error) {
+	tris, err := renderTriangles(s, cellSize)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Mesh{Tris: tris, index: strLoad(tris)}
+	m.min, m.max = meshBounds(tris)
+	return m, nil
+}
+
+// Intersects reports whether any triangle of m has a bounding box overlapping any triangle of
+// other - a broad-phase collision check, fast enough to run before committing to an export.
+func (m *Mesh) Intersects(other *Mesh) bool {
+	for _, t := range m.Tris {
+		min, max := t.bounds()
+		hit := false
+		other.index.Search([2]float64{min[0], min[1]}, [2]float64{max[0], max[1]}, func(_, _ [2]float64, data any) bool {
+			oMin, oMax := other.Tris[data.(int)].bounds()
+			if oMin[2] <= max[2] && oMax[2] >= min[2] {
+				hit = true
+				return false
+			}
+			return true
+		})
+		if hit {
+			return true
+		}
+	}
+	return false
+}
+
+// VerticalRay casts a ray straight up through (x, y) and returns every z where it crosses m's
+// surface, sorted ascending.
+func (m *Mesh) VerticalRay(x, y float64) []float64 {
+	point := [2]float64{x, y}
+
+	var zs []float64
+	m.index.Search(point, point, func(_, _ [2]float64, data any) bool {
+		if z, ok := rayTriangleZ(m.Tris[data.(int)], x, y); ok {
+			zs = append(zs, z)
+		}
+		return true
+	})
+
+	sort.Float64s(zs)
+	return zs
+}
+
+// Contains reports whether p is inside m, by counting how many times a vertical ray from p
+// crosses the surface above it - an odd count means p is inside.
+func (m *Mesh) Contains(p sdf.V3) bool {
+	crossings := 0
+	for _, z := range m.VerticalRay(p.X, p.Y) {
+		if z > p.Z {
+			crossings++
+		}
+	}
+	return crossings%2 == 1
+}
+
+//#region Triangle geometry
+
+// bounds returns t's axis-aligned bounding box.
+func (t Triangle) bounds() (min, max [3]float64) {
+	min = [3]float64{math.Inf(1), math.Inf(1), math.Inf(1)}
+	max = [3]float64{math.Inf(-1), math.Inf(-1), math.Inf(-1)}
+	for _, v := range t.V {
+		p := [3]float64{v.X, v.Y, v.Z}
+		for i := 0; i < 3; i++ {
+			if p[i] < min[i] {
+				min[i] = p[i]
+			}
+			if p[i] > max[i] {
+				max[i] = p[i]
+			}
+		}
+	}
+	return min, max
+}
+
+// rayTriangleZ intersects a vertical ray through (x, y) with t, returning the z of the crossing
+// and whether (x, y) actually falls within t's XY-projected footprint.
+func rayTriangleZ(t Triangle, x, y float64) (float64, bool) {
+	a, b, c := t.V[0], t.V[1], t.V[2]
+
+	det := (b.Y-c.Y)*(a.X-c.X) + (c.X-b.X)*(a.Y-c.Y)
+	if det == 0 {
+		return 0, false
+	}
+
+	l1 := ((b.Y-c.Y)*(x-c.X) + (c.X-b.X)*(y-c.Y)) / det
+	l2 := ((c.Y-a.Y)*(x-c.X) + (a.X-c.X)*(y-c.Y)) / det
+	l3 := 1 - l1 - l2
+
+	if l1 < 0 || l1 > 1 || l2 < 0 || l2 > 1 || l3 < 0 || l3 > 1 {
+		return 0, false
+	}
+
+	return l1*a.Z + l2*b.Z + l3*c.Z, true
+}
+
+// meshBounds returns the axis-aligned bounding box enclosing every triangle in tris.
+func meshBounds(tris []Triangle) (min, max [3]float64) {
+	min = [3]float64{math.Inf(1), math.Inf(1), math.Inf(1)}
+	max = [3]float64{math.Inf(-1), math.Inf(-1), math.Inf(-1)}
+	for _, t := range tris {
+		tMin, tMax := t.bounds()
+		for i := 0; i < 3; i++ {
+			if tMin[i] < min[i] {
+				min[i] = tMin[i]
+			}
+			if tMax[i] > max[i] {
+				max[i] = tMax[i]
+			}
+		}
+	}
+	return min, max
+}
+
+//#endregion Triangle geometry
+
+//#region Index
+
+// strLoad bulk-loads tris into an rtree using STR (sort-tile-recursive) packing: sort by x-center
+// and split into ceil(sqrt(n)) vertical slabs, sort each slab by y-center, then insert leaves -
+// giving the tree far better query locality than inserting triangles in rendering order. Only the
+// XY-projected bounds go into the index itself, since github.com/tidwall/rtree indexes two
+// dimensions; Z is checked by callers against each candidate's own triangle bounds.
+func strLoad(tris []Triangle) rtree.RTree {
+	type entry struct {
+		idx      int
+		min, max [3]float64
+		cx       float64
+	}
+
+	entries := make([]entry, len(tris))
+	for i, t := range tris {
+		min, max := t.bounds()
+		entries[i] = entry{idx: i, min: min, max: max, cx: (min[0] + max[0]) / 2}
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].cx < entries[j].cx })
+
+	n := len(entries)
+	slabCount := int(math.Ceil(math.Sqrt(float64(n))))
+	if slabCount < 1 {
+		slabCount = 1
+	}
+	slabSize := int(math.Ceil(float64(n) / float64(slabCount)))
+
+	var tree rtree.RTree
+	for i := 0; i < n; i += slabSize {
+		end := i + slabSize
+		if end > n {
+			end = n
+		}
+
+		slab := entries[i:end]
+		sort.Slice(slab, func(a, b int) bool {
+			return (slab[a].min[1]+slab[a].max[1])/2 < (slab[b].min[1]+slab[b].max[1])/2
+		})
+
+		for _, e := range slab {
+			min2 := [2]float64{e.min[0], e.min[1]}
+			max2 := [2]float64{e.max[0], e.max[1]}
+			tree.Insert(min2, max2, e.idx)
+		}
+	}
+
+	return tree
+}
+
+//#endregion Index
+
+//#region Rendering
+
+// renderTriangles meshes s at the given cell size by rendering it to a scratch binary STL with
+// sdfx and reading the triangles back - the same round trip render.meshViaSTL uses, kept
+// independent here so this package doesn't import the render package's internal triangle type.
+func renderTriangles(s sdf.SDF3, cellSize int) ([]Triangle, error) {
+	scratch, err := os.CreateTemp("", "pterosphera-mesh-*.stl")
+	if err != nil {
+		return nil, err
+	}
+	scratchPath := scratch.Name()
+	scratch.Close()
+	defer os.Remove(scratchPath)
+
+	sdfxrender.RenderSTL(s, cellSize, scratchPath)
+
+	return readBinarySTL(scratchPath)
+}
+
+// readBinarySTL parses a binary STL file back into a triangle slice.
+func readBinarySTL(path string) ([]Triangle, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+
+	var header [80]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, fmt.Errorf("mesh: reading STL header: %w", err)
+	}
+
+	var count uint32
+	if err := binary.Read(r, binary.LittleEndian, &count); err != nil {
+		return nil, fmt.Errorf("mesh: reading STL facet count: %w", err)
+	}
+
+	tris := make([]Triangle, 0, count)
+	for i := uint32(0); i < count; i++ {
+		var normal [3]float32
+		if err := binary.Read(r, binary.LittleEndian, &normal); err != nil {
+			return nil, err
+		}
+
+		var verts [3][3]float32
+		if err := binary.Read(r, binary.LittleEndian, &verts); err != nil {
+			return nil, err
+		}
+
+		var attrByteCount uint16
+		if err := binary.Read(r, binary.LittleEndian, &attrByteCount); err != nil {
+			return nil, err
+		}
+
+		var t Triangle
+		for j, v := range verts {
+			t.V[j] = sdf.V3{X: float64(v[0]), Y: float64(v[1]), Z: float64(v[2])}
+		}
+		tris = append(tris, t)
+	}
+
+	return tris, nil
+}
+
+//#endregion Rendering
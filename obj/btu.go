@@ -2,6 +2,7 @@ package obj
 
 import (
 	"github.com/deadsy/sdfx/sdf"
+	"github.com/ivanpointer/pterosphera/obj/threads"
 	"github.com/ivanpointer/pterosphera/render"
 )
 
@@ -24,6 +25,14 @@ type BTU struct {
 
 	// TotalH is the total height of the BTU (used to calculate where to put the ball).
 	TotalH float64
+
+	// ThreadDiameter, if non-zero, mounts the BTU with a self-tapping screw into an ISO metric
+	// threaded socket (see RenderThreadedSocket) instead of a friction-fit peg.
+	ThreadDiameter float64
+
+	// ThreadPitch overrides the standard coarse pitch for ThreadDiameter; 0 uses the threads
+	// package's ISO coarse pitch table.
+	ThreadPitch float64
 }
 
 // BTURender defines the render parameters for rendering a BTU.
@@ -64,9 +73,14 @@ func (b *BTU) Render(r BTURender) (sdf.SDF3, error) {
 	return btu, nil
 }
 
-// RenderPeg renders a peg version of a BTU (used for cutting holes), using a total peg height.
+// RenderPeg renders the cutter used to mount a BTU, using a total peg height: a friction-fit peg
+// by default, or - when ThreadDiameter is set - a self-tapping threaded socket instead.
 // Note: the peg base will always be the same length, the given height (h) determines the height of the head portion of the BTU.
 func (b *BTU) RenderPeg(h float64, r BTURender) (sdf.SDF3, error) {
+	if b.ThreadDiameter > 0 {
+		return b.RenderThreadedSocket(h, b.ThreadDiameter, b.ThreadPitch, r)
+	}
+
 	// Render the base
 	base, err := sdf.Cylinder3D(b.BaseH, b.BaseR+r.Settings.WeldShift, 0)
 	if err != nil {
@@ -87,3 +101,14 @@ func (b *BTU) RenderPeg(h float64, r BTURender) (sdf.SDF3, error) {
 	// Done
 	return peg, nil
 }
+
+// RenderThreadedSocket renders a threaded socket cutter sized to receive a self-tapping screw of
+// the given ISO metric diameter, for mounting BTU housings with screws instead of friction-fit
+// pegs. Subtract the result from the mounting boss with sdf.Difference3D.
+func (b *BTU) RenderThreadedSocket(h, threadDiameter, threadPitch float64, r BTURender) (sdf.SDF3, error) {
+	socket, err := threads.InternalThread(threadDiameter, h+r.Settings.WeldShift, threadPitch, threads.RightHanded, r.Settings.WeldShift)
+	if err != nil {
+		return nil, err
+	}
+	return sdf.Transform3D(socket, sdf.Translate3d(sdf.V3{Z: (h / -2) + r.Settings.WeldShift})), nil
+}
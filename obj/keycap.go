@@ -0,0 +1,357 @@
+package obj
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/deadsy/sdfx/sdf"
+	"github.com/ivanpointer/pterosphera/render"
+)
+
+// KeycapStem identifies the switch stem type a keycap mounts onto.
+type KeycapStem int
+
+const (
+	// KeycapStemMX is the cross-shaped stem used by Cherry MX and compatible switches.
+	KeycapStemMX KeycapStem = iota
+
+	// KeycapStemChoc is the rectangular nub stem used by Kailh Choc low-profile switches.
+	KeycapStemChoc
+
+	// KeycapStemAlps is the slotted stem used by Alps and Matias switches.
+	KeycapStemAlps
+)
+
+// KeycapProfile identifies a keycap sculpt family.
+type KeycapProfile int
+
+const (
+	// KeycapProfileDSA is a uniform, shallow spherical-dish profile.
+	KeycapProfileDSA KeycapProfile = iota
+
+	// KeycapProfileSA is a tall spherical-dish profile.
+	KeycapProfileSA
+
+	// KeycapProfileOEM is a angled, cylindrical-dish profile.
+	KeycapProfileOEM
+
+	// KeycapProfileXDA is a uniform, flat-topped spherical-dish profile.
+	KeycapProfileXDA
+)
+
+// KeycapUnit identifies a standard keycap unit width, or Keycap2uVertical for a 1u-wide, 2u-tall cap.
+type KeycapUnit float64
+
+const (
+	Keycap1u         KeycapUnit = 1
+	Keycap1_25u      KeycapUnit = 1.25
+	Keycap1_5u       KeycapUnit = 1.5
+	Keycap2u         KeycapUnit = 2
+	Keycap2_25u      KeycapUnit = 2.25
+	Keycap2uVertical KeycapUnit = -2
+)
+
+// KeycapHoming identifies an optional homing feature molded into the top of a keycap.
+type KeycapHoming int
+
+const (
+	// KeycapHomingNone adds no homing feature.
+	KeycapHomingNone KeycapHoming = iota
+
+	// KeycapHomingBar adds a raised bar (the classic F/J nub).
+	KeycapHomingBar
+
+	// KeycapHomingDeepDish scoops the whole top surface noticeably deeper.
+	KeycapHomingDeepDish
+)
+
+const (
+	// unitMM is the pitch of one keycap unit (1u), in mm.
+	unitMM = 19.05
+
+	// interCapGap is the small clearance left between adjacent keycaps.
+	interCapGap = 0.5
+
+	// stabilizerSpacing is the Cherry-style on-center spacing between stabilizer stems, in mm.
+	stabilizerSpacing = 11.938 * 2
+
+	// stabilizerUnitThreshold is the minimum unit width that gets stabilizer stems.
+	stabilizerUnitThreshold = 2
+
+	// mxStemX, mxStemY size the MX cross stem; mxCrossDepth/Width/X size the cross-shaped cavity
+	// cut from it so it grips the switch's cross post.
+	mxStemX      = 6.0
+	mxStemY      = 5.0
+	mxCrossDepth = 4.0
+	mxCrossWidth = 1.0
+	mxCrossX     = 4.0
+	mxStemRound  = 0.05
+	stemLength   = 15.0
+
+	// stemWeldOverlap raises a stem's top edge slightly above the shell's underside (Z=0), so
+	// seatStem's union has a thin overlap instead of an exact coincident seam.
+	stemWeldOverlap = 0.2
+
+	// dsaDishDepth, saDishDepth, xdaDishDepth set how far each spherical profile's dish scoops
+	// in at its center - DSA shallow, SA tall, XDA barely-there (flat-topped).
+	dsaDishDepth = 1.0
+	saDishDepth  = 2.5
+	xdaDishDepth = 0.4
+)
+
+// Keycap holds the dimensions of a printable keycap: a profiled, dished shell plus one or more
+// switch stems, sized to fit the switch socket it mounts onto.
+type Keycap struct {
+	// Stem selects the switch stem type the keycap mounts onto.
+	Stem KeycapStem
+
+	// Profile selects the sculpted top-surface dish family.
+	Profile KeycapProfile
+
+	// Unit selects the standard unit width (and, for Keycap2uVertical, orientation).
+	Unit KeycapUnit
+
+	// Height is the total height of the keycap, from the switch housing to the top of the dish.
+	Height float64
+
+	// WallThickness is the thickness of the keycap's side walls.
+	WallThickness float64
+
+	// Homing selects an optional homing feature for the top surface.
+	Homing KeycapHoming
+
+	// Socket is the switch socket this keycap's stem must clear - used to validate the stem
+	// geometry fits the socket's clip hole so the two parts print as a compatible pair.
+	Socket MXSwitchSocket
+}
+
+// KeycapRender holds the render options for a Keycap.
+type KeycapRender struct {
+	// Settings are the general render settings for this project.
+	Settings render.RenderSettings
+}
+
+// Render renders the keycap: its dished, profiled shell plus switch stem(s).
+func (k Keycap) Render(r KeycapRender) (sdf.SDF3, error) {
+	if err := k.validateStem(); err != nil {
+		return nil, err
+	}
+
+	shell, err := k.renderShell(r)
+	if err != nil {
+		return nil, err
+	}
+
+	stems, err := k.renderStems()
+	if err != nil {
+		return nil, err
+	}
+	cap := sdf.Union3D(shell, stems)
+
+	if k.Homing == KeycapHomingNone {
+		return cap, nil
+	}
+
+	homing, err := k.renderHoming()
+	if err != nil {
+		return nil, err
+	}
+	if k.Homing == KeycapHomingDeepDish {
+		return sdf.Difference3D(cap, homing), nil
+	}
+	return sdf.Union3D(cap, homing), nil
+}
+
+// footprint returns the keycap's overall width (X) and depth (Y), in mm.
+func (k Keycap) footprint() (w, d float64) {
+	if k.Unit == Keycap2uVertical {
+		return unitMM - interCapGap, (2 * unitMM) - interCapGap
+	}
+	return (float64(k.Unit) * unitMM) - interCapGap, unitMM - interCapGap
+}
+
+// renderShell renders the keycap's outer walls and dished top surface.
+func (k Keycap) renderShell(r KeycapRender) (sdf.SDF3, error) {
+	w, d := k.footprint()
+
+	outer, err := sdf.Box3D(sdf.V3{X: w, Y: d, Z: k.Height}, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	inner, err := sdf.Box3D(sdf.V3{X: w - (k.WallThickness * 2), Y: d - (k.WallThickness * 2), Z: k.Height}, 1)
+	if err != nil {
+		return nil, err
+	}
+	inner = sdf.Transform3D(inner, sdf.Translate3d(sdf.V3{Z: k.WallThickness + r.Settings.WeldShift}))
+
+	shell := sdf.Difference3D(outer, inner)
+	shell = sdf.Transform3D(shell, sdf.Translate3d(sdf.V3{Z: k.Height / 2}))
+
+	dish, err := k.renderDish(w, d, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdf.Difference3D(shell, dish), nil
+}
+
+// renderDish renders the cutter for the keycap's top-surface dish: spherical for DSA, SA and XDA
+// (differing in how deep the scoop cuts at the cap's center), cylindrical (swept along the row's
+// finger-travel axis) for OEM.
+func (k Keycap) renderDish(w, d float64, r KeycapRender) (sdf.SDF3, error) {
+	if k.Profile == KeycapProfileOEM {
+		dishR := d * 1.6
+		dish, err := sdf.Cylinder3D(w+r.Settings.WeldShift, dishR, 0)
+		if err != nil {
+			return nil, err
+		}
+		dish = sdf.Transform3D(dish, sdf.RotateY(degToRad(90)))
+		return sdf.Transform3D(dish, sdf.Translate3d(sdf.V3{Z: k.Height + dishR - 1})), nil
+	}
+
+	depth := dsaDishDepth
+	switch k.Profile {
+	case KeycapProfileSA:
+		depth = saDishDepth
+	case KeycapProfileXDA:
+		depth = xdaDishDepth
+	}
+
+	dishR := math.Max(w, d) * 1.1
+	dish, err := sdf.Sphere3D(dishR)
+	if err != nil {
+		return nil, err
+	}
+	return sdf.Transform3D(dish, sdf.Translate3d(sdf.V3{Z: k.Height + dishR - depth})), nil
+}
+
+// renderStems renders the switch stem, plus Cherry-style stabilizer stems for caps 2u or longer,
+// spaced along the cap's long axis (Y for Keycap2uVertical, X otherwise).
+func (k Keycap) renderStems() (sdf.SDF3, error) {
+	switchStem, err := k.renderSwitchStem()
+	if err != nil {
+		return nil, err
+	}
+
+	if math.Abs(float64(k.Unit)) < stabilizerUnitThreshold {
+		return switchStem, nil
+	}
+
+	stabStem, err := k.renderStabilizerStem()
+	if err != nil {
+		return nil, err
+	}
+	ofs := sdf.V3{X: stabilizerSpacing / 2}
+	if k.Unit == Keycap2uVertical {
+		ofs = sdf.V3{Y: stabilizerSpacing / 2}
+	}
+	left := sdf.Transform3D(stabStem, sdf.Translate3d(sdf.V3{X: -ofs.X, Y: -ofs.Y}))
+	right := sdf.Transform3D(stabStem, sdf.Translate3d(ofs))
+
+	return sdf.Union3D(switchStem, left, right), nil
+}
+
+// renderSwitchStem renders the stem that clips onto the switch itself, per k.Stem.
+func (k Keycap) renderSwitchStem() (sdf.SDF3, error) {
+	switch k.Stem {
+	case KeycapStemChoc:
+		stem, err := sdf.Box3D(sdf.V3{X: 5.0, Y: 5.0, Z: stemLength}, 0)
+		if err != nil {
+			return nil, err
+		}
+		return k.seatStem(stem, stemLength), nil
+
+	case KeycapStemAlps:
+		stem, err := sdf.Box3D(sdf.V3{X: 4.5, Y: 2.2, Z: stemLength}, 0)
+		if err != nil {
+			return nil, err
+		}
+		return k.seatStem(stem, stemLength), nil
+
+	default:
+		return k.renderMXStem()
+	}
+}
+
+// renderMXStem renders the classic MX cross stem: a box with a cross-shaped cavity cut from its
+// free end so it clips onto the switch's cross post.
+func (k Keycap) renderMXStem() (sdf.SDF3, error) {
+	ofs := stemLength - mxCrossDepth
+	s0, err := sdf.Box3D(sdf.V3{X: mxCrossX, Y: mxCrossWidth, Z: stemLength}, mxCrossX*mxStemRound)
+	if err != nil {
+		return nil, err
+	}
+	s1, err := sdf.Box3D(sdf.V3{X: mxCrossWidth, Y: mxStemY * (1.0 + 2.0*mxStemRound), Z: stemLength}, mxCrossX*mxStemRound)
+	if err != nil {
+		return nil, err
+	}
+	cavity := sdf.Transform3D(sdf.Union3D(s0, s1), sdf.Translate3d(sdf.V3{Z: -ofs}))
+
+	stem, err := sdf.Box3D(sdf.V3{X: mxStemX, Y: mxStemY, Z: stemLength}, mxStemX*mxStemRound)
+	if err != nil {
+		return nil, err
+	}
+	stem = sdf.Difference3D(stem, cavity)
+
+	return k.seatStem(stem, stemLength), nil
+}
+
+// renderStabilizerStem renders a stabilizer insert stem - a plain post, much simpler than the
+// switch stem since it only has to hold the wire stabilizer's plastic insert.
+func (k Keycap) renderStabilizerStem() (sdf.SDF3, error) {
+	const stabStemW, stabStemD, stabStemLen = 3.0, 1.5, 6.0
+
+	stem, err := sdf.Box3D(sdf.V3{X: stabStemW, Y: stabStemD, Z: stabStemLen}, 0)
+	if err != nil {
+		return nil, err
+	}
+	return k.seatStem(stem, stabStemLen), nil
+}
+
+// seatStem positions a stem (built centered on the origin, of the given length) so it hangs down
+// from the underside of the keycap shell (Z=0), its top edge poking stemWeldOverlap above Z=0
+// rather than sitting exactly flush, so the union with the shell welds cleanly.
+func (k Keycap) seatStem(stem sdf.SDF3, length float64) sdf.SDF3 {
+	ofs := (length / -2) + stemWeldOverlap
+	return sdf.Transform3D(stem, sdf.Translate3d(sdf.V3{Z: ofs}))
+}
+
+// renderHoming renders the optional homing feature molded into the top surface.
+func (k Keycap) renderHoming() (sdf.SDF3, error) {
+	w, d := k.footprint()
+
+	if k.Homing == KeycapHomingDeepDish {
+		dish, err := sdf.Sphere3D(math.Max(w, d) * 0.6)
+		if err != nil {
+			return nil, err
+		}
+		return sdf.Transform3D(dish, sdf.Translate3d(sdf.V3{Z: k.Height})), nil
+	}
+
+	// KeycapHomingBar - the classic raised F/J nub.
+	bar, err := sdf.Box3D(sdf.V3{X: w * 0.5, Y: 1.2, Z: 0.6}, 0.2)
+	if err != nil {
+		return nil, err
+	}
+	return sdf.Transform3D(bar, sdf.Translate3d(sdf.V3{Z: k.Height - 0.3})), nil
+}
+
+// validateStem checks the MX stem's cross dimensions against the switch socket's clip hole, so
+// the keycap and socket are guaranteed to fit together as printed.
+func (k Keycap) validateStem() error {
+	if k.Stem != KeycapStemMX {
+		return nil
+	}
+	if k.Socket.ClipHoleW == 0 && k.Socket.ClipHoleH == 0 && k.Socket.ClipHoleD == 0 {
+		// No socket given to validate against.
+		return nil
+	}
+	if mxCrossWidth > k.Socket.ClipHoleW {
+		return fmt.Errorf("obj: MX stem cross width %.2fmm exceeds socket ClipHoleW %.2fmm", mxCrossWidth, k.Socket.ClipHoleW)
+	}
+	if mxCrossDepth > k.Socket.ClipHoleD+k.Socket.SocketDepth {
+		return fmt.Errorf("obj: MX stem cross depth %.2fmm exceeds socket clip travel %.2fmm", mxCrossDepth, k.Socket.ClipHoleD+k.Socket.SocketDepth)
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+// Package mount provides dovetail, T-slot and rail-clamp adapter primitives, so printed modules
+// (a trackball socket, a switch plate, a wrist rest) can be slotted together instead of being
+// printed as one monolithic part.
+package mount
+
+import (
+	"math"
+
+	"github.com/deadsy/sdfx/sdf"
+)
+
+// Mount is implemented by each adapter primitive in this package - Dovetail, TSlot and
+// RailClamp - so callers can place any of them at a mount attach point interchangeably.
+type Mount interface {
+	// Male renders the tongue/plug half of the adapter, to be unioned onto a part.
+	Male() (sdf.SDF3, error)
+
+	// Female renders the matching socket/channel half of the adapter, to be unioned onto a part
+	// (it is itself a cavity-shaped solid, not a cutter - see Cutter for that).
+	Female() (sdf.SDF3, error)
+
+	// Cutter renders the channel shape the Male half occupies, enlarged by its Clearance, ready
+	// to be subtracted out of a host part with sdf.Difference3D.
+	Cutter() (sdf.SDF3, error)
+}
+
+// Dovetail is a classic trapezoidal dovetail slide: a tapered tongue that slots into a matching
+// tapered channel and can only be removed by sliding it out along the length axis.
+type Dovetail struct {
+	// ProfileWidth is the width of the tongue at its narrow end, the base it stands on.
+	ProfileWidth float64
+
+	// ProfileHeight is the height of each of the tongue's two stacked blocks (see wedge), so the
+	// tongue stands twice this tall.
+	ProfileHeight float64
+
+	// TaperAngleDeg is the half-angle the tongue's sides taper outward by, per side.
+	TaperAngleDeg float64
+
+	// Length is the length of the slide, along its sliding (Y) axis.
+	Length float64
+
+	// Clearance is the per-side gap added to the channel so the tongue slides freely.
+	Clearance float64
+}
+
+// Male renders the dovetail tongue, narrower at its base than at its tip; its sides are stepped
+// (see wedge), not sloped.
+func (d Dovetail) Male() (sdf.SDF3, error) {
+	return d.wedge(d.ProfileWidth, d.ProfileHeight, d.Length)
+}
+
+// Female renders a housing block with the dovetail channel cut into it - the actual socket a
+// caller unions onto a part to receive the matching tongue, as opposed to Cutter's bare
+// subtraction shape.
+func (d Dovetail) Female() (sdf.SDF3, error) {
+	channel, err := d.Cutter()
+	if err != nil {
+		return nil, err
+	}
+	return housing(channel, d.ProfileHeight)
+}
+
+// Cutter renders the dovetail channel, enlarged by Clearance, ready to cut out of a host part.
+func (d Dovetail) Cutter() (sdf.SDF3, error) {
+	return d.wedge(d.ProfileWidth+(d.Clearance*2), d.ProfileHeight+d.Clearance, d.Length)
+}
+
+// wedge builds the profile shared by Male/Female/Cutter as two stacked boxes extruded along
+// length: a base block width wide and height tall, centered on Z=0, topped by a tip block of the
+// same height that is wider by TaperAngleDeg per side over height. The sides step out once
+// rather than sloping, and the whole profile is 2*height tall.
+func (d Dovetail) wedge(width, height, length float64) (sdf.SDF3, error) {
+	topWidth := width + (2 * height * math.Tan(degToRad(d.TaperAngleDeg)))
+
+	base, err := sdf.Box3D(sdf.V3{X: width, Y: length, Z: height}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	tip, err := sdf.Box3D(sdf.V3{X: topWidth, Y: length, Z: height}, 0)
+	if err != nil {
+		return nil, err
+	}
+	tip = sdf.Transform3D(tip, sdf.Translate3d(sdf.V3{Z: height}))
+
+	return sdf.Union3D(base, tip), nil
+}
+
+// TSlot is a T-shaped slide: a narrow stem topped by a wide head, captured in a matching T-shaped
+// channel so the tongue resists pulling straight out, only sliding along the length axis.
+type TSlot struct {
+	// ProfileWidth is the width of the stem.
+	ProfileWidth float64
+
+	// ProfileHeight is the total height of the slide, stem plus head.
+	ProfileHeight float64
+
+	// HeadWidth is the width of the wide head that captures the slide.
+	HeadWidth float64
+
+	// HeadHeight is the height of the head, taken out of ProfileHeight.
+	HeadHeight float64
+
+	// Length is the length of the slide, along its sliding (Y) axis.
+	Length float64
+
+	// Clearance is the per-side gap added to the channel so the tongue slides freely.
+	Clearance float64
+}
+
+// Male renders the T-shaped tongue.
+func (t TSlot) Male() (sdf.SDF3, error) {
+	return t.tShape(t.ProfileWidth, t.HeadWidth, t.ProfileHeight, t.HeadHeight, t.Length)
+}
+
+// Female renders a housing block with the T-shaped channel cut into it - the actual socket a
+// caller unions onto a part to receive the matching tongue, as opposed to Cutter's bare
+// subtraction shape.
+func (t TSlot) Female() (sdf.SDF3, error) {
+	channel, err := t.Cutter()
+	if err != nil {
+		return nil, err
+	}
+	return housing(channel, t.ProfileHeight)
+}
+
+// Cutter renders the T-shaped channel, enlarged by Clearance, ready to cut out of a host part.
+func (t TSlot) Cutter() (sdf.SDF3, error) {
+	return t.tShape(t.ProfileWidth+(t.Clearance*2), t.HeadWidth+(t.Clearance*2), t.ProfileHeight+t.Clearance, t.HeadHeight+t.Clearance, t.Length)
+}
+
+// tShape builds the shared T cross-section, extruded along Length: a stem of stemW, topped by a
+// head of headW, totalling height tall.
+func (t TSlot) tShape(stemW, headW, height, headH, length float64) (sdf.SDF3, error) {
+	stemH := height - headH
+
+	stem, err := sdf.Box3D(sdf.V3{X: stemW, Y: length, Z: stemH}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := sdf.Box3D(sdf.V3{X: headW, Y: length, Z: headH}, 0)
+	if err != nil {
+		return nil, err
+	}
+	head = sdf.Transform3D(head, sdf.Translate3d(sdf.V3{Z: headH / 2}))
+	stem = sdf.Transform3D(stem, sdf.Translate3d(sdf.V3{Z: stemH / -2}))
+
+	return sdf.Union3D(stem, head), nil
+}
+
+// RailClamp clamps a printed module onto an existing rail (e.g. a 3D-printer's extrusion rail),
+// analogous to common x-carriage accessory mounts, without requiring the rail's host part to be
+// redesigned.
+type RailClamp struct {
+	// RailWidth is the width of the rail being clamped onto.
+	RailWidth float64
+
+	// RailHeight is the height of the rail being clamped onto.
+	RailHeight float64
+
+	// JawThickness is the thickness of the clamp's jaws, surrounding the rail.
+	JawThickness float64
+
+	// Length is the length of the clamp, along the rail's (Y) axis.
+	Length float64
+
+	// Clearance is the gap added around the rail so the clamp slides on freely before tightening.
+	Clearance float64
+}
+
+// Male renders the clamp body: a C-shaped channel that wraps around the rail.
+func (c RailClamp) Male() (sdf.SDF3, error) {
+	return c.channel(c.RailWidth, c.RailHeight)
+}
+
+// Female renders the rail channel itself - the space the rail occupies within the clamp.
+func (c RailClamp) Female() (sdf.SDF3, error) {
+	return sdf.Box3D(sdf.V3{X: c.RailWidth, Y: c.Length, Z: c.RailHeight}, 0)
+}
+
+// Cutter renders the rail channel, enlarged by Clearance, ready to cut the clamp's bore out of a
+// solid clamp body.
+func (c RailClamp) Cutter() (sdf.SDF3, error) {
+	return sdf.Box3D(sdf.V3{X: c.RailWidth + (c.Clearance * 2), Y: c.Length, Z: c.RailHeight + c.Clearance}, 0)
+}
+
+// channel builds the clamp body: a box sized to the rail plus its jaws, with the rail's channel
+// cut from one side so the clamp can be introduced onto the rail and then tightened.
+func (c RailClamp) channel(railW, railH float64) (sdf.SDF3, error) {
+	outer, err := sdf.Box3D(sdf.V3{X: railW + (c.JawThickness * 2), Y: c.Length, Z: railH + c.JawThickness}, 0)
+	if err != nil {
+		return nil, err
+	}
+	outer = sdf.Transform3D(outer, sdf.Translate3d(sdf.V3{Z: c.JawThickness / -2}))
+
+	bore, err := c.Cutter()
+	if err != nil {
+		return nil, err
+	}
+	bore = sdf.Transform3D(bore, sdf.Translate3d(sdf.V3{Z: c.JawThickness / 2}))
+
+	return sdf.Difference3D(outer, bore), nil
+}
+
+// housing wraps channel in a solid block sized to its bounding box plus a uniform wall margin on
+// each side (Y excepted, since channel already runs the slide's full length), with channel cut
+// out of it - turning a bare cutter shape into a standalone socket with a cavity already formed.
+func housing(channel sdf.SDF3, margin float64) (sdf.SDF3, error) {
+	bb := channel.BoundingBox()
+
+	size := sdf.V3{
+		X: (bb.Max.X - bb.Min.X) + (margin * 2),
+		Y: bb.Max.Y - bb.Min.Y,
+		Z: (bb.Max.Z - bb.Min.Z) + (margin * 2),
+	}
+	center := sdf.V3{
+		X: (bb.Min.X + bb.Max.X) / 2,
+		Y: (bb.Min.Y + bb.Max.Y) / 2,
+		Z: (bb.Min.Z + bb.Max.Z) / 2,
+	}
+
+	block, err := sdf.Box3D(size, 0)
+	if err != nil {
+		return nil, err
+	}
+	block = sdf.Transform3D(block, sdf.Translate3d(center))
+
+	return sdf.Difference3D(block, channel), nil
+}
+
+func degToRad(deg float64) float64 {
+	return deg * (math.Pi / 180)
+}
@@ -0,0 +1,57 @@
+package obj
+
+import (
+	"github.com/deadsy/sdfx/sdf"
+	"github.com/ivanpointer/pterosphera/obj/mount"
+)
+
+// MountSpec declares a single mount attach point in world space: where a mount package primitive
+// should be placed, and which of its halves belongs here.
+type MountSpec struct {
+	// Position is the world-space location of the attach point.
+	Position sdf.V3
+
+	// RotationDeg orients the mount's length axis, as a rotation about Z.
+	RotationDeg float64
+
+	// Mount is the dovetail/T-slot/rail-clamp primitive placed at this attach point.
+	Mount mount.Mount
+
+	// Female selects which half of Mount to apply here: true cuts the socket's Cutter out of the
+	// host part, false unions the tongue's Male half onto the attached module.
+	Female bool
+}
+
+// Mountable is implemented by obj.PterospheraParams members that expose mount attach points, so
+// the top-level renderer can automatically cut matching female sockets into one part and union
+// male tongues onto another at the declared world-space locations.
+type Mountable interface {
+	AttachPoints() []MountSpec
+}
+
+// ApplyMounts cuts or unions each attach point's Mount geometry into s, at its declared position
+// and rotation.
+func ApplyMounts(s sdf.SDF3, points []MountSpec) (sdf.SDF3, error) {
+	for _, p := range points {
+		var piece sdf.SDF3
+		var err error
+		if p.Female {
+			piece, err = p.Mount.Cutter()
+		} else {
+			piece, err = p.Mount.Male()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		piece = sdf.Transform3D(piece, sdf.RotateZ(degToRad(p.RotationDeg)))
+		piece = sdf.Transform3D(piece, sdf.Translate3d(p.Position))
+
+		if p.Female {
+			s = sdf.Difference3D(s, piece)
+		} else {
+			s = sdf.Union3D(s, piece)
+		}
+	}
+	return s, nil
+}
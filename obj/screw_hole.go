@@ -0,0 +1,209 @@
+package obj
+
+import (
+	"math"
+
+	"github.com/deadsy/sdfx/sdf"
+	"github.com/ivanpointer/pterosphera/obj/threads"
+	"github.com/ivanpointer/pterosphera/render"
+)
+
+// ScrewHeadStyle identifies a fastener head profile, used to cut a matching counterbore or
+// countersink.
+type ScrewHeadStyle int
+
+const (
+	// ScrewHeadPan is a shallow domed head that sits on top of the surface - no counterbore is cut.
+	ScrewHeadPan ScrewHeadStyle = iota
+
+	// ScrewHeadSocketCap is a cylindrical socket-head cap screw, recessed into a cylindrical counterbore.
+	ScrewHeadSocketCap
+
+	// ScrewHeadFlat is a flat/countersunk head, seated flush in a conical countersink.
+	ScrewHeadFlat
+
+	// ScrewHeadButton is a low-profile rounded head, recessed into a shallow cylindrical counterbore.
+	ScrewHeadButton
+)
+
+// ScrewHole models a real fastener hole: a shank bore plus a head-shaped counterbore or
+// countersink, so a mount can switch between e.g. wood-screw pilot holes and M3 socket-caps
+// without editing the mount's rendering code.
+type ScrewHole struct {
+	// ShankR is the radius of the clearance/pilot hole for the screw's shank.
+	ShankR float64
+
+	// ShankDepth is the depth of the shank hole, measured from the mating surface (Z=0).
+	ShankDepth float64
+
+	// HeadStyle selects the fastener head profile to cut a counterbore/countersink for.
+	HeadStyle ScrewHeadStyle
+
+	// HeadR is the radius of the head counterbore/countersink, at the mating surface.
+	HeadR float64
+
+	// HeadDepth is the depth of the head counterbore/countersink, measured from the mating surface.
+	HeadDepth float64
+
+	// CountersinkAngleDeg is the included angle (82 or 90) used to size a ScrewHeadFlat countersink;
+	// 0 defaults to 90. It sets a minimum depth only: the cone runs HeadDepth deep, or deeper when
+	// HeadDepth is too shallow to taper from HeadR to ShankR at this angle, so a HeadDepth deeper
+	// than that minimum gives a narrower cone.
+	CountersinkAngleDeg float64
+
+	// ThroughHole, when true, extends the shank hole through rather than stopping at ShankDepth.
+	ThroughHole bool
+
+	// ThreadDiameter, if non-zero, selects an ISO metric threaded shank (e.g. 3 for M3) via the
+	// threads package, so the screw self-taps into the printed plastic, instead of a plain
+	// cylindrical clearance shank.
+	ThreadDiameter float64
+
+	// ThreadPitch overrides the standard coarse pitch for ThreadDiameter; 0 uses the threads
+	// package's ISO coarse pitch table.
+	ThreadPitch float64
+}
+
+// ScrewHoleRender holds the render options for a ScrewHole.
+type ScrewHoleRender struct {
+	// Settings are the general render settings for this project.
+	Settings render.RenderSettings
+}
+
+// M3SocketCap returns a ScrewHole sized for an M3 socket-cap screw with the given shank length.
+func M3SocketCap(length float64) ScrewHole {
+	return ScrewHole{
+		ShankR:     3.2 / 2,
+		ShankDepth: length,
+		HeadStyle:  ScrewHeadSocketCap,
+		HeadR:      5.7 / 2,
+		HeadDepth:  3,
+	}
+}
+
+// M3Flat returns a ScrewHole sized for an M3 flat/countersunk screw with the given shank length.
+func M3Flat(length float64) ScrewHole {
+	return ScrewHole{
+		ShankR:              3.2 / 2,
+		ShankDepth:          length,
+		HeadStyle:           ScrewHeadFlat,
+		HeadR:               6 / 2,
+		HeadDepth:           1.7,
+		CountersinkAngleDeg: 90,
+	}
+}
+
+// M3Button returns a ScrewHole sized for an M3 button-head screw with the given shank length.
+func M3Button(length float64) ScrewHole {
+	return ScrewHole{
+		ShankR:     3.2 / 2,
+		ShankDepth: length,
+		HeadStyle:  ScrewHeadButton,
+		HeadR:      5.7 / 2,
+		HeadDepth:  1.65,
+	}
+}
+
+// M3Pan returns a ScrewHole sized for an M3 pan-head screw with the given shank length.
+func M3Pan(length float64) ScrewHole {
+	return ScrewHole{
+		ShankR:     3.2 / 2,
+		ShankDepth: length,
+		HeadStyle:  ScrewHeadPan,
+	}
+}
+
+// Render renders a single ScrewHole cutter, positioned so Z=0 sits at the mating surface and the
+// shank extends downward (-Z) into the material.
+func (s ScrewHole) Render(r ScrewHoleRender) (sdf.SDF3, error) {
+	shank, err := s.renderShank(r)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := s.renderHead(r)
+	if err != nil {
+		return nil, err
+	}
+	if head == nil {
+		return shank, nil
+	}
+
+	return sdf.Union3D(shank, head), nil
+}
+
+// RenderPair renders two mirrored ScrewHole cutters, spaced dist apart along X and centered on
+// the origin - the layout used by mounts with a pair of screw posts.
+func (s ScrewHole) RenderPair(dist float64, r ScrewHoleRender) (sdf.SDF3, error) {
+	h1, err := s.Render(r)
+	if err != nil {
+		return nil, err
+	}
+	h1 = sdf.Transform3D(h1, sdf.Translate3d(sdf.V3{X: dist / -2}))
+
+	h2, err := s.Render(r)
+	if err != nil {
+		return nil, err
+	}
+	h2 = sdf.Transform3D(h2, sdf.Translate3d(sdf.V3{X: dist / 2}))
+
+	return sdf.Union3D(h1, h2), nil
+}
+
+// renderShank renders the shank bore, threaded via the threads package when ThreadDiameter is set.
+func (s ScrewHole) renderShank(r ScrewHoleRender) (sdf.SDF3, error) {
+	depth := s.ShankDepth
+	if s.ThroughHole {
+		depth += r.Settings.WeldShift
+	}
+
+	if s.ThreadDiameter > 0 {
+		shank, err := threads.InternalThread(s.ThreadDiameter, depth+r.Settings.WeldShift, s.ThreadPitch, threads.RightHanded, r.Settings.WeldShift)
+		if err != nil {
+			return nil, err
+		}
+		return sdf.Transform3D(shank, sdf.Translate3d(sdf.V3{Z: (depth / -2) + r.Settings.WeldShift})), nil
+	}
+
+	shank, err := sdf.Cylinder3D(depth+r.Settings.WeldShift, s.ShankR, 0)
+	if err != nil {
+		return nil, err
+	}
+	return sdf.Transform3D(shank, sdf.Translate3d(sdf.V3{Z: (depth / -2) + r.Settings.WeldShift})), nil
+}
+
+// renderHead renders the counterbore/countersink for the configured HeadStyle, or nil if the
+// style (e.g. a pan head sitting atop the surface) needs no cut.
+func (s ScrewHole) renderHead(r ScrewHoleRender) (sdf.SDF3, error) {
+	switch s.HeadStyle {
+	case ScrewHeadSocketCap, ScrewHeadButton:
+		head, err := sdf.Cylinder3D(s.HeadDepth+r.Settings.WeldShift, s.HeadR, 0)
+		if err != nil {
+			return nil, err
+		}
+		return sdf.Transform3D(head, sdf.Translate3d(sdf.V3{Z: (s.HeadDepth / -2) + r.Settings.WeldShift})), nil
+
+	case ScrewHeadFlat:
+		angle := s.CountersinkAngleDeg
+		if angle == 0 {
+			angle = 90
+		}
+
+		// Make sure the countersink is deep enough to actually taper from HeadR down to ShankR
+		// at the given included angle, even if HeadDepth was left too shallow.
+		depth := s.HeadDepth
+		if minDepth := (s.HeadR - s.ShankR) / math.Tan(degToRad(angle/2)); minDepth > depth {
+			depth = minDepth
+		}
+
+		head, err := sdf.Cone3D(depth+r.Settings.WeldShift, s.ShankR, s.HeadR, 0)
+		if err != nil {
+			return nil, err
+		}
+		return sdf.Transform3D(head, sdf.Translate3d(sdf.V3{Z: (depth / -2) + r.Settings.WeldShift})), nil
+
+	default:
+		// ScrewHeadPan sits on top of the surface - no counterbore to cut.
+		return nil, nil
+	}
+}
@@ -0,0 +1,30 @@
+package obj
+
+import "github.com/deadsy/sdfx/sdf"
+
+// Shell carves a uniform-thickness shell out of solid, mirroring OCC's
+// BRepOffsetAPI_MakeThickSolid: offset solid inward by thickness, difference that out of the
+// original to keep only the skin, then clip away the regions whose closest point on the surface
+// lies within faceRadius of one of removeFaces' seed points - this package's stand-in for "the
+// faces BRepOffsetAPI_MakeThickSolid was told to remove" before it offsets the rest, e.g. the top
+// hemisphere left open for a lid, or the wall behind a recessed sensor mount. faceRadius should be
+// sized to the seed's own face (e.g. the part's local radius at that point), not the part's overall
+// bounding-box diagonal - too generous a radius clips straight through to the far side and removes
+// the whole shell instead of just the one face.
+func Shell(solid sdf.SDF3, thickness, faceRadius float64, removeFaces []sdf.V3) (sdf.SDF3, error) {
+	// sdf.Offset3D(s, r) evaluates to s.Evaluate(p)-r, so a negative r (here, -thickness) shifts
+	// the zero surface inward by thickness - eroding solid, not growing it.
+	eroded := sdf.Offset3D(solid, -thickness)
+	shell := sdf.Difference3D(solid, eroded)
+
+	for _, seed := range removeFaces {
+		cutter, err := sdf.Sphere3D(faceRadius)
+		if err != nil {
+			return nil, err
+		}
+		cutter = sdf.Transform3D(cutter, sdf.Translate3d(seed))
+		shell = sdf.Difference3D(shell, cutter)
+	}
+
+	return shell, nil
+}
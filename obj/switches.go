@@ -30,6 +30,15 @@ type MXSwitchSocket struct {
 
 	// ClipHoleD defines the depth of the hole for the clip of the MX switch.
 	ClipHoleD float64
+
+	// Mounts declares the dovetail/T-slot/rail-clamp attach points for slotting this switch
+	// plate together with other printed modules, e.g. the trackball socket.
+	Mounts []MountSpec
+}
+
+// AttachPoints implements Mountable.
+func (s MXSwitchSocket) AttachPoints() []MountSpec {
+	return s.Mounts
 }
 
 // MXSwitchSocketRender defines the render settings for a MX switch socket
@@ -84,6 +93,12 @@ func (s MXSwitchSocket) renderSocketHole(r MXSwitchSocketRender) (sdf.SDF3, erro
 
 	socket = sdf.Union3D(socket, plate)
 
+	// Apply any dovetail/T-slot/rail-clamp mount attach points
+	socket, err = ApplyMounts(socket, s.AttachPoints())
+	if err != nil {
+		return nil, err
+	}
+
 	// Send it!
 	return socket, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,162 @@
+// Package threads builds ISO metric threaded SDF3 solids, so that screws can self-thread
+// into printed plastic instead of relying on loose cone-shaped clearance holes.
+package threads
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/deadsy/sdfx/sdf"
+)
+
+// CoarsePitch maps an ISO metric nominal diameter (mm) to its standard coarse thread pitch (mm).
+var CoarsePitch = map[float64]float64{
+	2:   0.4,
+	2.5: 0.45,
+	3:   0.5,
+	4:   0.7,
+	5:   0.8,
+	6:   1.0,
+	8:   1.25,
+	10:  1.5,
+	12:  1.75,
+	16:  2.0,
+}
+
+// Handedness identifies the winding direction of a thread helix.
+type Handedness int
+
+const (
+	// RightHanded winds the thread the common way - clockwise, driven in by a clockwise turn.
+	RightHanded Handedness = iota
+
+	// LeftHanded winds the thread the opposite direction.
+	LeftHanded
+)
+
+// threadSegments is the number of wedge segments used to approximate one helical turn.
+const threadSegments = 60
+
+// LookupPitch returns the standard ISO coarse pitch for the given nominal diameter (e.g. 3 -> 0.5).
+func LookupPitch(diameter float64) (float64, error) {
+	p, ok := CoarsePitch[diameter]
+	if !ok {
+		return 0, fmt.Errorf("threads: no coarse pitch entry for M%v, pass an explicit pitch instead", diameter)
+	}
+	return p, nil
+}
+
+// ExternalThread renders a male ISO 60° triangular thread of the given nominal diameter and
+// length - suitable for a printed screw, or as the cutter used to carve a matching InternalThread
+// hole. If pitch is 0, the standard coarse pitch for diameter is looked up.
+func ExternalThread(diameter, length, pitch float64, hand Handedness, weldShift float64) (sdf.SDF3, error) {
+	pitch, err := resolvePitch(diameter, pitch)
+	if err != nil {
+		return nil, err
+	}
+
+	turn, err := threadTurn(diameter, pitch, hand)
+	if err != nil {
+		return nil, err
+	}
+
+	// Array the helix turn along Z to cover the requested length.
+	turns := int(math.Ceil(length / pitch))
+	segs := make([]sdf.SDF3, turns)
+	for i := 0; i < turns; i++ {
+		segs[i] = sdf.Transform3D(turn, sdf.Translate3d(sdf.V3{Z: pitch * float64(i)}))
+	}
+	helix := sdf.Union3D(segs...)
+
+	// The core cylinder the thread crests sit on - minor diameter per ISO 68-1.
+	coreR := (diameter / 2) - threadHeight(pitch)
+	core, err := sdf.Cylinder3D(length+weldShift, coreR, 0)
+	if err != nil {
+		return nil, err
+	}
+	core = sdf.Transform3D(core, sdf.Translate3d(sdf.V3{Z: length / 2}))
+
+	return sdf.Union3D(helix, core), nil
+}
+
+// InternalThread renders the cutter for a tapped hole of the given nominal diameter and length: a
+// bored cylinder with the matching male thread profile removed, so a self-tapping screw threads
+// into the resulting cavity. Subtract the result from a part with sdf.Difference3D.
+func InternalThread(diameter, length, pitch float64, hand Handedness, weldShift float64) (sdf.SDF3, error) {
+	pitch, err := resolvePitch(diameter, pitch)
+	if err != nil {
+		return nil, err
+	}
+
+	// A touch of clearance so the self-tapped screw doesn't bind in the plastic.
+	const clearance = 0.1
+
+	// ExternalThread's own helix and core sit in Z ∈ [0, length], not centered like Cylinder3D, so
+	// shift blank into that frame for the difference, then shift the result back to center it on
+	// the origin - matching the centered-at-origin convention callers rely on (e.g. screw_hole.go's
+	// renderShank and btu.go's RenderThreadedSocket both translate this result the same way they'd
+	// translate a plain Cylinder3D).
+	blank, err := sdf.Cylinder3D(length, (diameter/2)+clearance, 0)
+	if err != nil {
+		return nil, err
+	}
+	blank = sdf.Transform3D(blank, sdf.Translate3d(sdf.V3{Z: length / 2}))
+
+	screw, err := ExternalThread(diameter+clearance, length+(weldShift*2), pitch, hand, weldShift)
+	if err != nil {
+		return nil, err
+	}
+
+	cavity := sdf.Difference3D(blank, screw)
+	return sdf.Transform3D(cavity, sdf.Translate3d(sdf.V3{Z: -length / 2})), nil
+}
+
+// threadTurn builds one helical turn of the ISO 60° triangular thread profile: threadSegments
+// wedge segments, each climbing pitch/threadSegments in Z and rotating 2π/threadSegments about Z.
+func threadTurn(diameter, pitch float64, hand Handedness) (sdf.SDF3, error) {
+	ht := threadHeight(pitch)
+	crestR := (diameter / 2) - (pitch / 8)
+	rootR := (diameter / 2) - ht + (pitch / 4)
+
+	profileH := crestR - rootR
+	profileW := profileH * 2 * math.Tan(degToRad(30)) // 60° included V angle
+
+	zStep := pitch / float64(threadSegments)
+	aStep := (2 * math.Pi) / float64(threadSegments)
+	if hand == LeftHanded {
+		aStep = -aStep
+	}
+
+	segs := make([]sdf.SDF3, threadSegments)
+	for i := 0; i < threadSegments; i++ {
+		// Slightly overlap adjacent segments in Z so the welded helix has no paper-thin seams.
+		wedge, err := sdf.Box3D(sdf.V3{X: profileH, Y: profileW, Z: zStep * 1.05}, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		wedge = sdf.Transform3D(wedge, sdf.Translate3d(sdf.V3{X: (rootR + crestR) / 2, Z: zStep * float64(i)}))
+		wedge = sdf.Transform3D(wedge, sdf.RotateZ(aStep*float64(i)))
+
+		segs[i] = wedge
+	}
+
+	return sdf.Union3D(segs...), nil
+}
+
+// threadHeight returns the ISO 68-1 external thread depth for the given pitch, 0.6134·P.
+func threadHeight(pitch float64) float64 {
+	return 0.6134 * pitch
+}
+
+// resolvePitch returns pitch if given, otherwise looks up the standard coarse pitch for diameter.
+func resolvePitch(diameter, pitch float64) (float64, error) {
+	if pitch > 0 {
+		return pitch, nil
+	}
+	return LookupPitch(diameter)
+}
+
+func degToRad(deg float64) float64 {
+	return deg * (math.Pi / 180)
+}
@@ -0,0 +1,45 @@
+package threads
+
+import (
+	"math"
+	"testing"
+
+	"github.com/deadsy/sdfx/sdf"
+)
+
+// TestInternalThreadReliefSpansFullLength checks that InternalThread's thread relief is cut
+// across the whole bore, not just half of it - a regression check for a bug where blank and
+// screw were built in mismatched Z frames and only overlapped over half of length.
+func TestInternalThreadReliefSpansFullLength(t *testing.T) {
+	const diameter, pitch, length, weldShift = 3, 0.5, 10.0, 0.2
+
+	cavity, err := InternalThread(diameter, length, pitch, RightHanded, weldShift)
+	if err != nil {
+		t.Fatalf("InternalThread: %v", err)
+	}
+
+	// Just inside the thread crest: a plain bore has no material out here, but a properly
+	// threaded bore should have a crest blocking it at some angle around the ring, at any Z
+	// within the bore's length.
+	crestR := (diameter / 2) - (pitch / 8) - 0.01
+
+	for _, z := range []float64{-length/2 + 1, 0, length/2 - 1} {
+		if !ringHasMaterial(cavity, crestR, z) {
+			t.Errorf("z=%v: no thread crest found at radius %v - bore looks unthreaded here", z, crestR)
+		}
+	}
+}
+
+// ringHasMaterial reports whether s evaluates positive - outside the cutter s, where the host
+// part's material is left standing - at any point around a ring of the given radius and height z.
+func ringHasMaterial(s sdf.SDF3, radius, z float64) bool {
+	const steps = 72
+	for i := 0; i < steps; i++ {
+		a := degToRad(360 * float64(i) / steps)
+		p := sdf.V3{X: radius * math.Cos(a), Y: radius * math.Sin(a), Z: z}
+		if s.Evaluate(p) > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -31,6 +31,54 @@ type TrackballSocket struct {
 
 	// The BTU settings for individual BTUs.
 	BTU BTU
+
+	// BTUs, if set, overrides the uniform BTUCount/BTUOffsetZ ring with an explicit slice of
+	// bearing placements, e.g. two low for stability and one high under the palm.
+	BTUs []BTUPlacement
+
+	// Mounts declares the dovetail/T-slot/rail-clamp attach points for slotting this socket
+	// together with other printed modules, e.g. a switch plate or a wrist rest.
+	Mounts []MountSpec
+
+	// SensorMount, if set, recesses a PMW3360-style optical sensor mount directly into the
+	// bottom of the socket instead of it being a separate printed part.
+	SensorMount *TrackballSensorMount
+
+	// SensorDistFromBall is the standoff between the sensor's lens and the trackball's surface,
+	// along the mount's own axis - the PMW3360's datasheet focus distance.
+	SensorDistFromBall float64
+
+	// SensorAngleY tilts the sensor mount about Y, away from the true bottom pole, so its cable
+	// channel can be routed clear of a BTU or mount attach point.
+	SensorAngleY float64
+
+	// TopPlateEdgeRadius fillets the top plate's edges - most notably the ridge where its flat
+	// top meets the conical bore - before it's unioned with the socket. Zero leaves it sharp.
+	TopPlateEdgeRadius EdgeRadius
+
+	// SocketEdgeRadius fillets the socket shell's edges - most notably the seam where it meets
+	// the top plate - before the two are unioned. Zero leaves it sharp.
+	SocketEdgeRadius EdgeRadius
+}
+
+// EdgeRadius bundles the outer/inner fillet radii passed to render.RoundEdges: Outer rounds
+// convex edges, Inner rounds concave ones. Both zero is a no-op.
+type EdgeRadius struct {
+	// Outer is the radius used to round the solid's convex (outside) edges.
+	Outer float64
+
+	// Inner is the radius used to round the solid's concave (inside) edges.
+	Inner float64
+}
+
+// isZero reports whether e has no rounding to apply.
+func (e EdgeRadius) isZero() bool {
+	return e.Outer == 0 && e.Inner == 0
+}
+
+// AttachPoints implements Mountable.
+func (s TrackballSocket) AttachPoints() []MountSpec {
+	return s.Mounts
 }
 
 // TrackballSocketRender holds the options for rendering the trackball socket.
@@ -54,12 +102,18 @@ func (s TrackballSocket) Render(r TrackballSocketRender) (sdf.SDF3, error) {
 	if err != nil {
 		return nil, err
 	}
+	if !s.SocketEdgeRadius.isZero() {
+		socket = render.RoundEdges(socket, s.SocketEdgeRadius.Outer, s.SocketEdgeRadius.Inner)
+	}
 
 	// Add the top plate
 	topPlate, err := s.renderTopPlate(r)
 	if err != nil {
 		return nil, err
 	}
+	if !s.TopPlateEdgeRadius.isZero() {
+		topPlate = render.RoundEdges(topPlate, s.TopPlateEdgeRadius.Outer, s.TopPlateEdgeRadius.Inner)
+	}
 	socket = sdf.Union3D(socket, topPlate)
 
 	// Cut out the holes for the BTUs
@@ -68,6 +122,18 @@ func (s TrackballSocket) Render(r TrackballSocketRender) (sdf.SDF3, error) {
 		return nil, err
 	}
 
+	// Apply any dovetail/T-slot/rail-clamp mount attach points
+	socket, err = ApplyMounts(socket, s.AttachPoints())
+	if err != nil {
+		return nil, err
+	}
+
+	// Recess the optical sensor mount into the bottom of the socket, if configured
+	socket, err = s.applySensorMount(socket, r)
+	if err != nil {
+		return nil, err
+	}
+
 	// Add the trackball
 	if r.RenderTrackball {
 		tb, err := s.RenderTrackball()
@@ -83,33 +149,22 @@ func (s TrackballSocket) Render(r TrackballSocketRender) (sdf.SDF3, error) {
 
 //#region Socket
 
-// renderSocket renders the socket for the trackball.
+// renderSocket renders the socket for the trackball: a uniform-thickness shell of the outer
+// sphere, built with obj.Shell, with the top hemisphere removed for the top plate's opening and,
+// when a sensor mount is configured, the bottom pole also removed to open the wall for its pocket.
 func (s TrackballSocket) renderSocket() (sdf.SDF3, error) {
-	// Build the outer socket
 	radius := s.socketOuterRadius()
 	outer, err := sdf.Sphere3D(radius)
 	if err != nil {
 		return nil, err
 	}
 
-	// Build a box to use to cut off the top half of the socket
-	dia := radius * 2
-	x, y, z := dia, dia, radius
-	b, err := sdf.Box3D(sdf.V3{X: x, Y: y, Z: z}, 0)
-	if err != nil {
-		return nil, err
+	removeFaces := []sdf.V3{{Z: radius}}
+	if s.SensorMount != nil {
+		removeFaces = append(removeFaces, sdf.V3{Z: -radius})
 	}
-	topHalfCut := sdf.Transform3D(b, sdf.Translate3d(sdf.V3{X: 0, Y: 0, Z: radius / 2}))
-
-	// Cut the top half of the socket off
-	socket := sdf.Difference3D(outer, topHalfCut)
 
-	// Scoop out the inside of the socket
-	innerCut, err := sdf.Sphere3D(radius - s.WallThickness)
-	socket = sdf.Difference3D(socket, innerCut)
-
-	// Return the build socket
-	return socket, nil
+	return Shell(outer, s.WallThickness, radius, removeFaces)
 }
 
 // renderTopPlate renders the top plate for the socket - the piece that holds the trackball into the socket.
@@ -155,63 +210,112 @@ func (s TrackballSocket) cutBTUHoles(b sdf.SDF3, r TrackballSocketRender) (sdf.S
 
 // RenderBTUs renders the BTUs for the socket (instead of the holes).
 func (s TrackballSocket) RenderBTUs(r TrackballSocketRender) (sdf.SDF3, error) {
-	return s.rotBTUs(func() (sdf.SDF3, error) {
-		return s.BTU.Render(BTURender{
+	return s.rotBTUs(func(b BTU) (sdf.SDF3, error) {
+		return b.Render(BTURender{
 			Settings: r.Settings,
 		})
 	}, r)
 }
 
 func (s TrackballSocket) RenderBTUPegs(h float64, r TrackballSocketRender) (sdf.SDF3, error) {
-	return s.rotBTUs(func() (sdf.SDF3, error) {
-		return s.BTU.RenderPeg(h, BTURender{
+	return s.rotBTUs(func(b BTU) (sdf.SDF3, error) {
+		return b.RenderPeg(h, BTURender{
 			Settings: r.Settings,
 		})
 	}, r)
 }
 
-// rotBTUs generates BTUs (or their holes), rotating around the trackball and pointing to its center.
-func (s TrackballSocket) rotBTUs(genBTU func() (sdf.SDF3, error), r TrackballSocketRender) (sdf.SDF3, error) {
-	// Work out the radius of our sphere at the given height (from the bottom)
-	radius := radiusAtDistFromCenter(s.TrackballR, s.TrackballR-s.BTUOffsetZ)
-	ms := make([]sdf.SDF3, s.BTUCount)
+// BTUPlacement declares a single BTU's bearing on the ball's surface, overriding the uniform
+// ring driven by BTUCount/BTUOffsetZ - e.g. two low for stability and one high under the palm,
+// with a slight tilt to relieve drag on one axis.
+type BTUPlacement struct {
+	// AzimuthDeg is the bearing's rotation about Z.
+	AzimuthDeg float64
 
-	// Render each BTU
-	ai := float64(360) / float64(s.BTUCount)
-	for i := 0; i < s.BTUCount; i++ {
-		// Work out the maths for the rotations
-		deg := ai * float64(i)
+	// ElevationDeg is the bearing's angle up from the equator; the ring's BTUs sit at a negative
+	// elevation, toward the bottom pole.
+	ElevationDeg float64
+
+	// TiltDeg rotates the bearing about its own local tangent, after it's oriented toward the
+	// ball's center, to relieve drag along one axis.
+	TiltDeg float64
 
-		// Render the BTU
-		btu, err := genBTU()
+	// BTU overrides TrackballSocket.BTU for this placement; nil uses the socket's default.
+	BTU *BTU
+}
+
+// rotBTUs generates BTUs (or their holes) at each of s.BTUs, falling back to the uniform ring
+// when BTUs isn't set, each pointing at the ball's center.
+func (s TrackballSocket) rotBTUs(genBTU func(b BTU) (sdf.SDF3, error), r TrackballSocketRender) (sdf.SDF3, error) {
+	placements := s.BTUs
+	if len(placements) == 0 {
+		placements = s.ringBTUPlacements()
+	}
+
+	ms := make([]sdf.SDF3, len(placements))
+	for i, p := range placements {
+		btu := s.BTU
+		if p.BTU != nil {
+			btu = *p.BTU
+		}
+
+		m, err := genBTU(btu)
 		if err != nil {
 			return nil, err
 		}
 
-		// Work out the elevation to point the BTUs at the center of the trackball
-		centerElev := s.TrackballR - s.BTUOffsetZ
-		yRad := math.Atan2(radius, centerElev)
-		yDeg := radToDeg(yRad)
-		yRad = degToRad(yDeg)
+		ms[i] = s.placeBTU(m, btu, p)
+	}
+
+	// Merge all our BTUs together
+	return sdf.Union3D(ms...), nil
+}
+
+// ringBTUPlacements converts the legacy BTUCount/BTUOffsetZ ring into an equivalent slice of
+// BTUPlacements, so rotBTUs has a single placement path regardless of which config was used.
+func (s TrackballSocket) ringBTUPlacements() []BTUPlacement {
+	placements := make([]BTUPlacement, s.BTUCount)
 
-		// Rotational magics all around the ball
-		btu = sdf.Transform3D(btu, sdf.Translate3d(sdf.V3{Z: s.BTU.TotalH / -2}))
-		btu = sdf.Transform3D(btu, sdf.RotateY(yRad))
-		btu = sdf.Transform3D(btu, sdf.Translate3d(sdf.V3{X: radius * -1}))
-		btu = sdf.Transform3D(btu, sdf.RotateZ(degToRad(deg)))
+	elevDeg := radToDeg(math.Asin((s.BTUOffsetZ - s.TrackballR) / s.TrackballR))
+	ai := float64(360) / float64(s.BTUCount)
 
-		// Add the BTU to our collection
-		ms[i] = btu
+	for i := 0; i < s.BTUCount; i++ {
+		placements[i] = BTUPlacement{
+			AzimuthDeg:   (ai * float64(i)) + 180,
+			ElevationDeg: elevDeg,
+		}
 	}
 
-	// Merge all our BTUs together
-	m := sdf.Union3D(ms...)
+	return placements
+}
 
-	// Move the ring of BTUs down to cradle the trackball
-	m = sdf.Transform3D(m, sdf.Translate3d(sdf.V3{Z: (s.TrackballR * -1) + s.BTUOffsetZ}))
+// placeBTU positions a single rendered BTU (or its peg) on the ball's surface at p's azimuth and
+// elevation, oriented so its axis points at the ball's center, then applies p.TiltDeg as an extra
+// rotation about its own local tangent.
+func (s TrackballSocket) placeBTU(m sdf.SDF3, btu BTU, p BTUPlacement) sdf.SDF3 {
+	elevRad := degToRad(p.ElevationDeg)
+	azRad := degToRad(p.AzimuthDeg)
+
+	// Position on the ball's surface at the given azimuth/elevation.
+	pos := sdf.V3{
+		X: s.TrackballR * math.Cos(elevRad) * math.Cos(azRad),
+		Y: s.TrackballR * math.Cos(elevRad) * math.Sin(azRad),
+		Z: s.TrackballR * math.Sin(elevRad),
+	}
+
+	// Orient the peg's axis toward the ball's center: tilt it off vertical by the elevation's
+	// complement, relieve drag with TiltDeg about its own tangent, then spin it to the azimuth.
+	// The RotateY angle is negated so the peg's local +Z ends up pointing back at the origin
+	// (i.e. along -pos), rather than straight out along pos.
+	m = sdf.Transform3D(m, sdf.Translate3d(sdf.V3{Z: btu.TotalH / -2}))
+	m = sdf.Transform3D(m, sdf.RotateX(degToRad(p.TiltDeg)))
+	m = sdf.Transform3D(m, sdf.RotateY(-((math.Pi / 2) + elevRad)))
+	m = sdf.Transform3D(m, sdf.RotateZ(azRad))
+
+	// Move it out to its position on the ball's surface.
+	m = sdf.Transform3D(m, sdf.Translate3d(pos))
 
-	// Send the rendered BTUs
-	return m, nil
+	return m
 }
 
 func degToRad(deg float64) float64 {
@@ -222,14 +326,68 @@ func radToDeg(rad float64) float64 {
 	return rad / (math.Pi / 180)
 }
 
-// radiusAtDistFromCenter calculates the radius of a cross-section of a sphere at the given distance from the center.
-func radiusAtDistFromCenter(radius float64, distance float64) float64 {
-	return math.Sqrt(math.Pow(radius, 2) - math.Pow(distance, 2))
-}
-
 // socketOuterRadius calculates the outer radius for the socket, and other components (like the top plate).
 func (s TrackballSocket) socketOuterRadius() float64 {
 	return s.TrackballR + s.WallThickness + s.SocketClearance
 }
 
 //#endregion Socket
+
+//#region Sensor Mount
+
+// applySensorMount recesses s.SensorMount into the bottom of b: the PCB pocket, lens aperture and
+// cable channel are cut away, and the mount's threaded bosses are unioned back in, so the
+// sensor's own screws thread straight into the socket wall.
+func (s TrackballSocket) applySensorMount(b sdf.SDF3, r TrackballSocketRender) (sdf.SDF3, error) {
+	if s.SensorMount == nil {
+		return b, nil
+	}
+
+	sm := *s.SensorMount
+	smr := TrackballSensorMountRender{Settings: r.Settings}
+
+	pocket, err := sm.PocketCut(smr)
+	if err != nil {
+		return nil, err
+	}
+
+	lens, err := sm.LensCut(smr)
+	if err != nil {
+		return nil, err
+	}
+
+	cable, err := sm.CableChannelCut(smr)
+	if err != nil {
+		return nil, err
+	}
+
+	holes, err := sm.ScrewHoles(smr)
+	if err != nil {
+		return nil, err
+	}
+
+	cut := sdf.Union3D(pocket, lens, cable)
+	b = sdf.Difference3D(b, s.sensorMountTransform(cut))
+
+	bosses, err := sm.Bosses(smr)
+	if err != nil {
+		return nil, err
+	}
+	b = sdf.Union3D(b, s.sensorMountTransform(bosses))
+
+	// Bore the screw holes after the bosses are in, so the bosses don't fill them back up
+	b = sdf.Difference3D(b, s.sensorMountTransform(holes))
+
+	return b, nil
+}
+
+// sensorMountTransform places sensor mount geometry at the socket's bottom pole, standing it off
+// from the ball's surface by SensorDistFromBall and then tilting it about the ball's center by
+// SensorAngleY.
+func (s TrackballSocket) sensorMountTransform(m sdf.SDF3) sdf.SDF3 {
+	m = sdf.Transform3D(m, sdf.Translate3d(sdf.V3{Z: -(s.TrackballR + s.SensorDistFromBall)}))
+	m = sdf.Transform3D(m, sdf.RotateY(degToRad(s.SensorAngleY)))
+	return m
+}
+
+//#endregion Sensor Mount
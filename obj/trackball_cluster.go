@@ -0,0 +1,126 @@
+package obj
+
+import (
+	"math"
+
+	"github.com/deadsy/sdfx/sdf"
+)
+
+// TrackballCluster fuses a TrackballSocket with a ring of switch plates arranged around its
+// outside, as in a thumb cluster, with a skirt filling the gap between each plate and the ball.
+type TrackballCluster struct {
+	// Socket is the trackball socket the cluster is built around.
+	Socket TrackballSocket
+
+	// Keys places each switch plate on the socket's outer surface.
+	Keys []ClusterKey
+}
+
+// ClusterKey places a single switch plate on a TrackballCluster's socket surface, oriented the
+// same way as BTUPlacement: azimuth/elevation locate it on the sphere, TiltDeg relieves drag (or
+// here, angles a key toward the thumb's natural travel) about its own tangent, and RadialOffset
+// stands it off from the socket wall.
+type ClusterKey struct {
+	// Plate is the switch plate rendered at this position.
+	Plate MXSwitchSocket
+
+	// AzimuthDeg is the plate's bearing, rotated about Z.
+	AzimuthDeg float64
+
+	// ElevationDeg is the plate's angle up from the equator.
+	ElevationDeg float64
+
+	// TiltDeg rotates the plate about its own local tangent, after it's oriented outward.
+	TiltDeg float64
+
+	// RadialOffset stands the plate off from the socket's outer surface, along its outward
+	// normal; the skirt fills the gap this leaves.
+	RadialOffset float64
+}
+
+// Render renders the socket, then fuses each of c.Keys's switch plates onto its outer surface,
+// with a skirt closing the gap between the plate's bottom edge and the ball.
+func (c TrackballCluster) Render(r TrackballSocketRender) (sdf.SDF3, error) {
+	socket, err := c.Socket.Render(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range c.Keys {
+		plate, err := key.Plate.Render(MXSwitchSocketRender{Settings: r.Settings})
+		if err != nil {
+			return nil, err
+		}
+		socket = sdf.Union3D(socket, c.placeKey(plate, key))
+
+		skirt, err := c.renderKeySkirt(key)
+		if err != nil {
+			return nil, err
+		}
+		if skirt != nil {
+			socket = sdf.Union3D(socket, skirt)
+		}
+	}
+
+	return socket, nil
+}
+
+// placeKey positions a rendered switch plate on the socket's outer surface at key's
+// azimuth/elevation, shifting its bottom face to the local origin first so that face - rather
+// than the plate's center - lands on the sphere, then orienting its hole axis along the outward
+// surface normal, same as placeBTU does for a BTU's axis.
+func (c TrackballCluster) placeKey(m sdf.SDF3, key ClusterKey) sdf.SDF3 {
+	elevRad := degToRad(key.ElevationDeg)
+	azRad := degToRad(key.AzimuthDeg)
+	dist := c.Socket.socketOuterRadius() + key.RadialOffset
+
+	pos := sdf.V3{
+		X: dist * math.Cos(elevRad) * math.Cos(azRad),
+		Y: dist * math.Cos(elevRad) * math.Sin(azRad),
+		Z: dist * math.Sin(elevRad),
+	}
+
+	// The RotateY angle is (π/2 - elevRad), not (π/2 + elevRad): the plate's local +Z should end
+	// up pointing outward along pos (away from the ball's center), the mirror image of placeBTU's
+	// inward-pointing peg axis.
+	socketD := key.Plate.SocketDepth - key.Plate.TopPlateDepth
+	m = sdf.Transform3D(m, sdf.Translate3d(sdf.V3{Z: socketD / 2}))
+	m = sdf.Transform3D(m, sdf.RotateX(degToRad(key.TiltDeg)))
+	m = sdf.Transform3D(m, sdf.RotateY((math.Pi/2)-elevRad))
+	m = sdf.Transform3D(m, sdf.RotateZ(azRad))
+	m = sdf.Transform3D(m, sdf.Translate3d(pos))
+
+	return m
+}
+
+// renderKeySkirt builds the fillet region that fills the gap left by key.RadialOffset between the
+// socket's outer surface and the plate's bottom edge: a cone swept along the same outward normal
+// used by placeKey, flared where it meets the ball's curvature and narrowing to the plate's own
+// footprint, approximating a hull between the two. Returns nil if the key sits flush (no gap).
+func (c TrackballCluster) renderKeySkirt(key ClusterKey) (sdf.SDF3, error) {
+	if key.RadialOffset <= 0 {
+		return nil, nil
+	}
+
+	plateHalfW := key.Plate.SocketWH / 2
+	skirt, err := sdf.Cone3D(key.RadialOffset, plateHalfW*1.2, plateHalfW, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	elevRad := degToRad(key.ElevationDeg)
+	azRad := degToRad(key.AzimuthDeg)
+	dist := c.Socket.socketOuterRadius() + (key.RadialOffset / 2)
+	pos := sdf.V3{
+		X: dist * math.Cos(elevRad) * math.Cos(azRad),
+		Y: dist * math.Cos(elevRad) * math.Sin(azRad),
+		Z: dist * math.Sin(elevRad),
+	}
+
+	// Same outward orientation as placeKey: (π/2 - elevRad), not (π/2 + elevRad).
+	skirt = sdf.Transform3D(skirt, sdf.RotateY((math.Pi/2)-elevRad))
+	skirt = sdf.Transform3D(skirt, sdf.RotateZ(azRad))
+	skirt = sdf.Transform3D(skirt, sdf.Translate3d(pos))
+
+	return skirt, nil
+}
@@ -0,0 +1,42 @@
+package obj
+
+import (
+	"math"
+	"testing"
+
+	"github.com/deadsy/sdfx/sdf"
+)
+
+// TestPlaceKeyPointsOutward checks, for a handful of azimuth/elevation pairs, that placeKey
+// orients a plate's local +Z axis outward along the socket's surface normal, the mirror image of
+// placeBTU's inward-pointing peg axis.
+func TestPlaceKeyPointsOutward(t *testing.T) {
+	c := TrackballCluster{Socket: TrackballSocket{TrackballR: 30, WallThickness: 3, SocketClearance: 0.3}}
+	key := ClusterKey{}
+
+	cases := []struct {
+		az, elev float64
+	}{
+		{0, 0},
+		{90, -20},
+		{217, 35},
+		{350, -10},
+	}
+
+	for _, cs := range cases {
+		key.AzimuthDeg, key.ElevationDeg = cs.az, cs.elev
+
+		dir := axisDirection(t, func(m sdf.SDF3) sdf.SDF3 { return c.placeKey(m, key) })
+
+		elevRad, azRad := degToRad(cs.elev), degToRad(cs.az)
+		want := sdf.V3{
+			X: math.Cos(elevRad) * math.Cos(azRad),
+			Y: math.Cos(elevRad) * math.Sin(azRad),
+			Z: math.Sin(elevRad),
+		}
+
+		if dot := dir.X*want.X + dir.Y*want.Y + dir.Z*want.Z; dot < 0.999 {
+			t.Errorf("az=%v elev=%v: plate axis points %+v, want %+v (dot=%v)", cs.az, cs.elev, dir, want, dot)
+		}
+	}
+}
@@ -10,17 +10,12 @@ type TrackballSensorMount struct {
 	// ScrewDist defines the distance between the screw holes (center).
 	ScrewDist float64
 
-	// ScrewRTop is the top radius of the screw hole.
-	ScrewRTop float64
+	// Screw defines the fastener hole used to mount the sensor - swap factory helpers like
+	// M3SocketCap or M3Pan to change fastener style without touching the rendering code.
+	Screw ScrewHole
 
-	// ScrewRBottom is the bottom radius of the screw hole.
-	ScrewRBottom float64
-
-	// ScrewMargin defines the width of the walls around the screw holes.
-	ScrewMargin float64
-
-	// ScrewDepth defines the depth of the holes for the screw holes.
-	ScrewDepth float64
+	// ScrewWallMargin defines the width of the walls around the screw holes.
+	ScrewWallMargin float64
 
 	// BaseH defines the height of the sensor mount base.
 	BaseH float64
@@ -33,6 +28,21 @@ type TrackballSensorMount struct {
 
 	// LensHoleR is the radius of the hole for the sensor lens.
 	LensHoleR float64
+
+	// PCBWidth and PCBHeight size the pocket cut for the sensor's PCB, independent of the screw
+	// spacing, so odd-shaped boards still get a tight pocket.
+	PCBWidth float64
+
+	// See PCBWidth.
+	PCBHeight float64
+
+	// CableChannelW is the width of the channel cut through a host wall for the sensor's ribbon
+	// cable to exit.
+	CableChannelW float64
+
+	// CableChannelExitAngleDeg rotates the cable channel about Z, so it can be routed clear of a
+	// BTU or mount attach point instead of always sitting at the pocket's +Y edge.
+	CableChannelExitAngleDeg float64
 }
 
 // TrackballSensorMountRender holds the render options for the trackball sensor mount.
@@ -54,13 +64,13 @@ func (m TrackballSensorMount) Render(r TrackballSensorMountRender) (sdf.SDF3, er
 
 	if !r.ForCut {
 		// Set up the screw holes
-		sh, err := m.renderScrewHoles(r)
+		sh, err := m.ScrewHoles(r)
 		if err != nil {
 			return nil, err
 		}
 
 		// Set up the lens hole
-		lh, err := m.renderLensHole(r)
+		lh, err := m.LensCut(r)
 		if err != nil {
 			return nil, err
 		}
@@ -73,7 +83,7 @@ func (m TrackballSensorMount) Render(r TrackballSensorMountRender) (sdf.SDF3, er
 		return sm, nil
 	} else {
 		// Render the hole for the sensor lens
-		sh, err := m.renderLensHole(r)
+		sh, err := m.LensCut(r)
 		if err != nil {
 			return nil, err
 		}
@@ -86,30 +96,45 @@ func (m TrackballSensorMount) Render(r TrackballSensorMountRender) (sdf.SDF3, er
 	}
 }
 
-func (m TrackballSensorMount) renderScrewHoles(r TrackballSensorMountRender) (sdf.SDF3, error) {
-	s1, err := m.renderScrewHole(r)
+// ScrewHoles renders the pair of fastener holes that secure the sensor mount.
+func (m TrackballSensorMount) ScrewHoles(r TrackballSensorMountRender) (sdf.SDF3, error) {
+	return m.Screw.RenderPair(m.ScrewDist, ScrewHoleRender{Settings: r.Settings})
+}
+
+// LensCut renders the through-hole for the sensor's lens, centered on the mount's origin.
+func (m TrackballSensorMount) LensCut(r TrackballSensorMountRender) (sdf.SDF3, error) {
+	// Render the hole
+	height := m.BaseD * 4
+	return sdf.Cylinder3D(height, m.LensHoleR, 0)
+}
+
+// PocketCut renders the pocket cut for the sensor's PCB, sized by PCBWidth/PCBHeight rather than
+// the screw spacing, so a host part can recess the PCB flush regardless of how the screw posts
+// are laid out.
+func (m TrackballSensorMount) PocketCut(r TrackballSensorMountRender) (sdf.SDF3, error) {
+	depth := m.BaseD + m.SensorClearance
+	b, err := sdf.Box3D(sdf.V3{X: m.PCBWidth, Y: m.PCBHeight, Z: depth}, 0)
 	if err != nil {
 		return nil, err
 	}
-	s1 = sdf.Transform3D(s1, sdf.Translate3d(sdf.V3{X: m.ScrewDist / -2}))
 
-	s2, err := m.renderScrewHole(r)
+	return sdf.Transform3D(b, sdf.Translate3d(sdf.V3{Z: depth / -2})), nil
+}
+
+// CableChannelCut renders the channel cut through a host wall for the sensor's ribbon cable to
+// exit, starting at the pocket's edge and angled about Z by CableChannelExitAngleDeg.
+func (m TrackballSensorMount) CableChannelCut(r TrackballSensorMountRender) (sdf.SDF3, error) {
+	length := m.BaseD * 6
+
+	c, err := sdf.Box3D(sdf.V3{X: m.CableChannelW, Y: m.CableChannelW, Z: length}, 0)
 	if err != nil {
 		return nil, err
 	}
-	s2 = sdf.Transform3D(s2, sdf.Translate3d(sdf.V3{X: m.ScrewDist / 2}))
 
-	return sdf.Union3D(s1, s2), nil
-}
-
-func (m TrackballSensorMount) renderScrewHole(r TrackballSensorMountRender) (sdf.SDF3, error) {
-	return sdf.Cone3D(m.ScrewDepth+r.Settings.WeldShift, m.ScrewRBottom, m.ScrewRTop, 0)
-}
+	c = sdf.Transform3D(c, sdf.Translate3d(sdf.V3{Y: m.PCBHeight / 2, Z: -m.BaseD}))
+	c = sdf.Transform3D(c, sdf.RotateZ(degToRad(m.CableChannelExitAngleDeg)))
 
-func (m TrackballSensorMount) renderLensHole(r TrackballSensorMountRender) (sdf.SDF3, error) {
-	// Render the hole
-	height := m.BaseD * 4
-	return sdf.Cylinder3D(height, m.LensHoleR, 0)
+	return c, nil
 }
 
 func (m TrackballSensorMount) renderSensorMount(r TrackballSensorMountRender) (sdf.SDF3, error) {
@@ -127,7 +152,7 @@ func (m TrackballSensorMount) renderSensorMount(r TrackballSensorMountRender) (s
 	b = sdf.Transform3D(b, sdf.Translate3d(sdf.V3{Z: depth / -2}))
 
 	// Screw walls
-	sw, err := m.renderScrewWalls(r)
+	sw, err := m.Bosses(r)
 	if err != nil {
 		return nil, err
 	}
@@ -139,7 +164,9 @@ func (m TrackballSensorMount) renderSensorMount(r TrackballSensorMountRender) (s
 	return b, nil
 }
 
-func (m TrackballSensorMount) renderScrewWalls(r TrackballSensorMountRender) (sdf.SDF3, error) {
+// Bosses renders the pair of threaded screw bosses at ScrewDist spacing, solid - a host part
+// unions these in, and ScrewHoles cuts the fastener bores through them.
+func (m TrackballSensorMount) Bosses(r TrackballSensorMountRender) (sdf.SDF3, error) {
 	// Render each screw wall
 	w1, err := m.renderScrewWall()
 	if err != nil {
@@ -159,7 +186,7 @@ func (m TrackballSensorMount) renderScrewWalls(r TrackballSensorMountRender) (sd
 
 func (m TrackballSensorMount) renderScrewWall() (sdf.SDF3, error) {
 	h := m.screwHoleWallHeight()
-	sw, err := sdf.Cylinder3D(h, m.ScrewRTop+m.ScrewMargin, m.ScrewMargin)
+	sw, err := sdf.Cylinder3D(h, m.screwBossR()+m.ScrewWallMargin, m.ScrewWallMargin)
 	if err != nil {
 		return nil, err
 	}
@@ -170,11 +197,21 @@ func (m TrackballSensorMount) renderScrewWall() (sdf.SDF3, error) {
 }
 
 func (m TrackballSensorMount) screwHoleWallHeight() float64 {
-	return m.ScrewDepth + m.ScrewMargin
+	return m.Screw.ShankDepth + m.ScrewWallMargin
+}
+
+// screwBossR returns the larger of the screw's shank and head radii, so the wall around it
+// clears whichever is wider.
+func (m TrackballSensorMount) screwBossR() float64 {
+	r := m.Screw.ShankR
+	if m.Screw.HeadR > r {
+		r = m.Screw.HeadR
+	}
+	return r
 }
 
 func (m TrackballSensorMount) sensorMountWidth(r TrackballSensorMountRender) float64 {
-	screwDia := (m.ScrewRTop + m.ScrewMargin) * 2
+	screwDia := (m.screwBossR() + m.ScrewWallMargin) * 2
 	w := m.ScrewDist + (screwDia * 2)
 	if r.ForCut {
 		w = w - r.Settings.WeldShift
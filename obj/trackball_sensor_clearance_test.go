@@ -0,0 +1,50 @@
+package obj
+
+import (
+	"math"
+	"testing"
+
+	"github.com/deadsy/sdfx/sdf"
+)
+
+// TestSensorBallClearance checks that the sensor mount's standoff actually keeps its lens clear
+// of the trackball's surface, rather than sitting inside or flush with the ball.
+func TestSensorBallClearance(t *testing.T) {
+	s := TrackballSocket{TrackballR: 30, SensorDistFromBall: 5}
+
+	pos := markerCenter(t, func(m sdf.SDF3) sdf.SDF3 { return s.sensorMountTransform(m) }, 0)
+	dist := math.Sqrt(pos.X*pos.X + pos.Y*pos.Y + pos.Z*pos.Z)
+	clearance := dist - s.TrackballR
+
+	if clearance <= 0 {
+		t.Fatalf("sensor mount sits %v from the ball center (radius %v): lens would intersect the ball", dist, s.TrackballR)
+	}
+	if math.Abs(clearance-s.SensorDistFromBall) > 1e-9 {
+		t.Errorf("sensor-to-ball clearance = %v, want SensorDistFromBall = %v", clearance, s.SensorDistFromBall)
+	}
+}
+
+// TestSensorBTURingClearance checks that the ring of BTUs stays at least a BTU head radius away
+// from the sensor mount, so a BTU hole can't be cut through the sensor's pocket.
+func TestSensorBTURingClearance(t *testing.T) {
+	s := TrackballSocket{
+		TrackballR:         30,
+		BTUCount:           3,
+		BTUOffsetZ:         8,
+		BTU:                BTU{HeadR: 4},
+		SensorDistFromBall: 5,
+	}
+
+	sensorPos := markerCenter(t, func(m sdf.SDF3) sdf.SDF3 { return s.sensorMountTransform(m) }, 0)
+	minClearance := s.BTU.HeadR
+
+	for _, p := range s.ringBTUPlacements() {
+		btuPos := markerCenter(t, func(m sdf.SDF3) sdf.SDF3 { return s.placeBTU(m, s.BTU, p) }, 0)
+
+		d := math.Sqrt(math.Pow(btuPos.X-sensorPos.X, 2) + math.Pow(btuPos.Y-sensorPos.Y, 2) + math.Pow(btuPos.Z-sensorPos.Z, 2))
+		if d < minClearance {
+			t.Errorf("az=%v elev=%v: BTU sits %v from the sensor mount, want at least %v (BTU head radius)",
+				p.AzimuthDeg, p.ElevationDeg, d, minClearance)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package obj
+
+import (
+	"math"
+	"testing"
+
+	"github.com/deadsy/sdfx/sdf"
+)
+
+// TestPlaceBTUPointsAtCenter checks, for a handful of azimuth/elevation pairs, that placeBTU
+// orients a peg's local +Z axis back at the ball's center rather than out away from it.
+func TestPlaceBTUPointsAtCenter(t *testing.T) {
+	s := TrackballSocket{TrackballR: 30}
+	btu := BTU{}
+
+	cases := []BTUPlacement{
+		{AzimuthDeg: 0, ElevationDeg: 0},
+		{AzimuthDeg: 90, ElevationDeg: -30},
+		{AzimuthDeg: 217, ElevationDeg: -50},
+		{AzimuthDeg: 350, ElevationDeg: 15},
+	}
+
+	for _, p := range cases {
+		dir := axisDirection(t, func(m sdf.SDF3) sdf.SDF3 { return s.placeBTU(m, btu, p) })
+
+		elevRad, azRad := degToRad(p.ElevationDeg), degToRad(p.AzimuthDeg)
+		want := sdf.V3{
+			X: -math.Cos(elevRad) * math.Cos(azRad),
+			Y: -math.Cos(elevRad) * math.Sin(azRad),
+			Z: -math.Sin(elevRad),
+		}
+
+		if dot := dir.X*want.X + dir.Y*want.Y + dir.Z*want.Z; dot < 0.999 {
+			t.Errorf("az=%v elev=%v: peg axis points %+v, want %+v (dot=%v)", p.AzimuthDeg, p.ElevationDeg, dir, want, dot)
+		}
+	}
+}
+
+// axisDirection runs two marker spheres - one at place's local origin, one 10 units out along
+// local +Z - through place's transform pipeline, and returns the unit vector from the first
+// marker's placed center to the second's, i.e. the direction place oriented local +Z toward.
+func axisDirection(t *testing.T, place func(sdf.SDF3) sdf.SDF3) sdf.V3 {
+	t.Helper()
+
+	const markerDist = 10
+
+	origin := markerCenter(t, place, 0)
+	tip := markerCenter(t, place, markerDist)
+
+	d := sdf.V3{X: tip.X - origin.X, Y: tip.Y - origin.Y, Z: tip.Z - origin.Z}
+	return sdf.V3{X: d.X / markerDist, Y: d.Y / markerDist, Z: d.Z / markerDist}
+}
+
+// markerCenter places a small marker sphere at local (0, 0, zOffset), runs it through place, and
+// returns the bounding-box center of the result.
+func markerCenter(t *testing.T, place func(sdf.SDF3) sdf.SDF3, zOffset float64) sdf.V3 {
+	t.Helper()
+
+	marker, err := sdf.Sphere3D(0.5)
+	if err != nil {
+		t.Fatalf("building marker: %v", err)
+	}
+	marker = sdf.Transform3D(marker, sdf.Translate3d(sdf.V3{Z: zOffset}))
+
+	bb := place(marker).BoundingBox()
+	return sdf.V3{
+		X: (bb.Min.X + bb.Max.X) / 2,
+		Y: (bb.Min.Y + bb.Max.Y) / 2,
+		Z: (bb.Min.Z + bb.Max.Z) / 2,
+	}
+}
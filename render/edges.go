@@ -0,0 +1,20 @@
+package render
+
+import "github.com/deadsy/sdfx/sdf"
+
+// RoundEdges fillets every edge of s: outside (convex) edges are rounded by outerR, inside
+// (concave) edges by innerR. It's the classic offset-pair trick - rounding outside edges is a
+// "closing" (erode(grow(s, outerR), outerR)), rounding inside edges is an "opening"
+// (grow(erode(s, innerR), innerR)), and running both in sequence rounds every edge. sdf.Offset3D
+// grows its result's bounding box by the offset it's given, so chaining the four offsets below
+// leaves the final envelope at least outerR+innerR larger than s's own bounding box - with room
+// to spare for the erode pass that follows each dilate - so neither offset clips the geometry.
+func RoundEdges(s sdf.SDF3, outerR, innerR float64) sdf.SDF3 {
+	// Round outside edges: grow then shrink back by outerR.
+	closed := sdf.Offset3D(sdf.Offset3D(s, outerR), -outerR)
+
+	// Round inside edges: shrink then grow back by innerR.
+	opened := sdf.Offset3D(sdf.Offset3D(closed, -innerR), innerR)
+
+	return opened
+}
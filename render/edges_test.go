@@ -0,0 +1,40 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/deadsy/sdfx/sdf"
+)
+
+// TestRoundEdgesContainedInDilatedOuter checks that RoundEdges never grows a solid past what
+// dilating it by outerR alone would reach - i.e. every point RoundEdges considers inside the
+// rounded solid is also inside solid dilated by outerR.
+func TestRoundEdgesContainedInDilatedOuter(t *testing.T) {
+	box, err := sdf.Box3D(sdf.V3{X: 10, Y: 10, Z: 10}, 0)
+	if err != nil {
+		t.Fatalf("building box: %v", err)
+	}
+
+	const outerR, innerR = 1.0, 0.5
+	rounded := RoundEdges(box, outerR, innerR)
+	dilated := sdf.Offset3D(box, outerR)
+
+	const steps = 6
+	const extent = 7.0
+	for xi := -steps; xi <= steps; xi++ {
+		for yi := -steps; yi <= steps; yi++ {
+			for zi := -steps; zi <= steps; zi++ {
+				p := sdf.V3{
+					X: extent * float64(xi) / steps,
+					Y: extent * float64(yi) / steps,
+					Z: extent * float64(zi) / steps,
+				}
+
+				if rounded.Evaluate(p) <= 0 && dilated.Evaluate(p) > 0 {
+					t.Fatalf("p=%+v: rounded is inside (%v) but dilated-by-outerR is outside (%v)",
+						p, rounded.Evaluate(p), dilated.Evaluate(p))
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package render
+
+import (
+	"encoding/json"
+	"os"
+)
+
+//#region Manifest
+
+// manifestEntry describes one part written by RenderParts, for manifest.json.
+type manifestEntry struct {
+	Name                string       `json:"name"`
+	Material            MaterialType `json:"material"`
+	Format              DestFormat   `json:"format"`
+	Orientation         string       `json:"orientation,omitempty"`
+	SupportsRecommended bool         `json:"supportsRecommended"`
+}
+
+// writeManifest writes entries out as indented JSON at path.
+func writeManifest(path string, entries []manifestEntry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+//#endregion Manifest
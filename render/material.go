@@ -0,0 +1,81 @@
+package render
+
+import "github.com/deadsy/sdfx/sdf"
+
+//#region Materials
+
+// DestFormat identifies the output file format a part is written in.
+type DestFormat string
+
+const (
+	// FormatSTLBinary writes a binary STL, via sdfx's own renderer.
+	FormatSTLBinary DestFormat = "stl"
+
+	// FormatSTLASCII writes a plain-text STL.
+	FormatSTLASCII DestFormat = "stl-ascii"
+
+	// FormatOBJ writes a Wavefront OBJ.
+	FormatOBJ DestFormat = "obj"
+
+	// Format3MF writes a 3MF package.
+	Format3MF DestFormat = "3mf"
+)
+
+// MaterialType identifies a render material.
+type MaterialType string
+
+const (
+	// MaterialTypeGeneric identifies a generic (default) render material.
+	MaterialTypeGeneric = "generic"
+
+	// MaterialTypePLA identifies a PLA type render material.
+	MaterialTypePLA = "pla"
+
+	// MaterialTypeABS identifies an ABS type render material.
+	MaterialTypeABS = "abs"
+
+	// MaterialTypeResinMSLA identifies a generic MSLA resin render material.
+	MaterialTypeResinMSLA = "resin-msla"
+)
+
+// Material holds the adjustments for render in a specific material.
+type Material struct {
+	Type MaterialType
+
+	// Shrinkage is the per-axis compensation scale applied before meshing, to counteract the
+	// material's cooling/curing shrinkage. FDM plastics typically shrink more along Z (layer
+	// stacking) than in the XY plane; resin cured under UV barely shrinks at all.
+	Shrinkage sdf.V3
+}
+
+// NewMaterial builds a Material with the same shrinkage compensation applied uniformly to all
+// three axes, for materials (or callers) that don't need to distinguish XY from Z.
+func NewMaterial(t MaterialType, shrinkage float64) Material {
+	return NewMaterialXYZ(t, shrinkage, shrinkage)
+}
+
+// NewMaterialXYZ builds a Material with independent XY-plane and Z-axis shrinkage compensation.
+func NewMaterialXYZ(t MaterialType, xy, z float64) Material {
+	return Material{
+		Type:      t,
+		Shrinkage: sdf.V3{X: xy, Y: xy, Z: z},
+	}
+}
+
+var (
+	// MaterialGeneric holds the general (default) adjustments for render: no shrinkage
+	// compensation.
+	MaterialGeneric = NewMaterial(MaterialTypeGeneric, 1)
+
+	// MaterialPLA holds the adjustments for render with a generic PLA.
+	MaterialPLA = NewMaterialXYZ(MaterialTypePLA, 1.002, 1.003)
+
+	// MaterialABS holds the adjustments for render with a generic ABS.
+	MaterialABS = NewMaterialXYZ(MaterialTypeABS, 1.006, 1.008)
+
+	// MaterialResinMSLA holds the adjustments for render with a generic MSLA resin, which shrinks
+	// negligibly compared to FDM plastics.
+	MaterialResinMSLA = NewMaterial(MaterialTypeResinMSLA, 1.0)
+)
+
+//#endregion Materials
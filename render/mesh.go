@@ -0,0 +1,84 @@
+package render
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/deadsy/sdfx/sdf"
+)
+
+//#region Mesh
+
+// triangle is a single facet of a triangle mesh, matching the layout sdfx's binary STL writer
+// uses: a normal followed by three vertices, all single-precision.
+type triangle struct {
+	Normal   [3]float32
+	Vertices [3][3]float32
+}
+
+// meshViaSTL meshes s at the given resolution by rendering it to a scratch binary STL with sdfx
+// and reading the triangles back, so the writers below (ASCII STL, OBJ, 3MF) share sdfx's own
+// meshing pass instead of re-deriving it. s has already had its caller's shrinkage compensation
+// applied, so this renders with MaterialGeneric's identity shrinkage rather than re-scaling it.
+func meshViaSTL(s sdf.SDF3, meshCells int) ([]triangle, error) {
+	scratch, err := os.CreateTemp("", "pterosphera-mesh-*.stl")
+	if err != nil {
+		return nil, err
+	}
+	scratchPath := scratch.Name()
+	scratch.Close()
+	defer os.Remove(scratchPath)
+
+	rs := RenderSettings{DestSTL: scratchPath, MeshCells: meshCells, Material: MaterialGeneric}
+	if err := RenderSTL(s, rs); err != nil {
+		return nil, err
+	}
+
+	return readBinarySTL(scratchPath)
+}
+
+// readBinarySTL parses a binary STL file back into its triangle mesh.
+func readBinarySTL(path string) ([]triangle, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+
+	var header [80]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, fmt.Errorf("render: reading STL header: %w", err)
+	}
+
+	var count uint32
+	if err := binary.Read(r, binary.LittleEndian, &count); err != nil {
+		return nil, fmt.Errorf("render: reading STL facet count: %w", err)
+	}
+
+	tris := make([]triangle, 0, count)
+	for i := uint32(0); i < count; i++ {
+		var t triangle
+		if err := binary.Read(r, binary.LittleEndian, &t.Normal); err != nil {
+			return nil, err
+		}
+		if err := binary.Read(r, binary.LittleEndian, &t.Vertices); err != nil {
+			return nil, err
+		}
+
+		var attrByteCount uint16
+		if err := binary.Read(r, binary.LittleEndian, &attrByteCount); err != nil {
+			return nil, err
+		}
+
+		tris = append(tris, t)
+	}
+
+	return tris, nil
+}
+
+//#endregion Mesh
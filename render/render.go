@@ -1,24 +1,107 @@
+// Package render writes built sdf.SDF3 models out to disk, applying per-material shrinkage
+// compensation and dispatching to the writer for the requested output format.
 package render
 
 import (
-	"github.com/deadsy/sdfx/render"
-	"github.com/deadsy/sdfx/sdf"
+	"fmt"
 	"os"
 	"path/filepath"
+
+	"github.com/deadsy/sdfx/render"
+	"github.com/deadsy/sdfx/sdf"
 )
 
 //#region Rendering
 
-// RenderSTL renders the given sdf.SDF3 model into a STL using the given RenderSettings.
+// RenderSTL renders the given sdf.SDF3 model into a binary STL using the given RenderSettings.
+// It's kept around for callers rendering a single part at a time - see RenderParts for jobs that
+// emit several named files (and a manifest.json) from one invocation.
 func RenderSTL(s sdf.SDF3, rs RenderSettings) error {
-	// Prepare the dest
 	if err := os.MkdirAll(filepath.Dir(rs.DestSTL), os.ModePerm); err != nil {
 		return err
 	}
 
-	// Render the SDF
-	render.RenderSTL(sdf.ScaleUniform3D(s, rs.Shrink()), rs.MeshCells, rs.DestSTL)
-	return nil
+	return writePart(s, rs, rs.DestSTL, FormatSTLBinary)
+}
+
+// RenderParts renders every part in rs.Parts into rs.DestDir, one file per part, plus a sidecar
+// manifest.json describing the material, orientation hint and support recommendation for each
+// part - e.g. socket.stl, sensor_mount.stl and switch_plate.stl from a single Pterosphera build.
+func RenderParts(rs RenderSettings) error {
+	if err := os.MkdirAll(rs.DestDir, os.ModePerm); err != nil {
+		return err
+	}
+
+	entries := make([]manifestEntry, 0, len(rs.Parts))
+	for _, p := range rs.Parts {
+		format := p.Format
+		if format == "" {
+			format = rs.DestFormat
+		}
+		if format == "" {
+			format = FormatSTLBinary
+		}
+
+		path := filepath.Join(rs.DestDir, p.Name+extensionFor(format))
+		if err := writePart(p.Model, rs, path, format); err != nil {
+			return fmt.Errorf("render: part %q: %w", p.Name, err)
+		}
+
+		entries = append(entries, manifestEntry{
+			Name:                p.Name,
+			Material:            rs.Material.Type,
+			Format:              format,
+			Orientation:         p.Orientation,
+			SupportsRecommended: p.SupportsRecommended,
+		})
+	}
+
+	return writeManifest(filepath.Join(rs.DestDir, "manifest.json"), entries)
+}
+
+// writePart applies rs's material shrinkage compensation to s and writes it to path in the given
+// format, dispatching to the matching sdfx renderer or, for formats sdfx doesn't support, one of
+// this package's own writers.
+func writePart(s sdf.SDF3, rs RenderSettings, path string, format DestFormat) error {
+	scaled := sdf.Transform3D(s, sdf.Scale3d(rs.Shrink()))
+
+	switch format {
+	case FormatSTLBinary:
+		render.RenderSTL(scaled, rs.MeshCells, path)
+		return nil
+	case FormatSTLASCII:
+		tris, err := meshViaSTL(scaled, rs.MeshCells)
+		if err != nil {
+			return err
+		}
+		return writeSTLASCII(tris, path)
+	case FormatOBJ:
+		tris, err := meshViaSTL(scaled, rs.MeshCells)
+		if err != nil {
+			return err
+		}
+		return writeOBJ(tris, path)
+	case Format3MF:
+		tris, err := meshViaSTL(scaled, rs.MeshCells)
+		if err != nil {
+			return err
+		}
+		return write3MF(tris, path)
+	default:
+		return fmt.Errorf("render: unsupported format %q", format)
+	}
+}
+
+// extensionFor returns the file extension used for a given DestFormat.
+func extensionFor(format DestFormat) string {
+	switch format {
+	case FormatOBJ:
+		return ".obj"
+	case Format3MF:
+		return ".3mf"
+	default:
+		return ".stl"
+	}
 }
 
 //#endregion Rendering
@@ -27,66 +110,53 @@ func RenderSTL(s sdf.SDF3, rs RenderSettings) error {
 
 // RenderSettings carries the settings for rendering the built model.
 type RenderSettings struct {
-	// DestSTL identifies the file that the STL is generated to.
+	// DestSTL identifies the file that a single-part binary STL is generated to, for callers
+	// using RenderSTL. Multi-part jobs use DestDir and Parts instead.
 	DestSTL string
 
+	// DestDir is the directory RenderParts writes its parts and manifest.json into.
+	DestDir string
+
+	// DestFormat selects the output format for parts that don't override it via PartSpec.Format.
+	DestFormat DestFormat
+
 	// MeshCells identifies the number of cells on the longest axis.
 	MeshCells int
 
-	// Material identifies the material being printed in, making adjustments to the rendered STL.
+	// Material identifies the material being printed in, making adjustments to the rendered part.
 	Material Material
 
 	// WeldShift slightly shifts object for cut holes and unions to make the STL more uniform.
 	WeldShift float64
+
+	// Parts, if set, is the set of named models RenderParts renders in one invocation.
+	Parts []PartSpec
 }
 
-// Shrink returns the shrinkage for the current material.
-func (s RenderSettings) Shrink() float64 {
+// Shrink returns the per-axis shrinkage-compensation scale for the current material.
+func (s RenderSettings) Shrink() sdf.V3 {
 	return s.Material.Shrinkage
 }
 
-//#endregion Settings
-
-//#region Materials
+// PartSpec names one model to render as part of a RenderParts job.
+type PartSpec struct {
+	// Name is the part's file name, without directory or extension - the extension is derived
+	// from Format (falling back to the job's DestFormat).
+	Name string
 
-// MaterialType identifies a render material.
-type MaterialType string
+	// Model is the solid to render for this part.
+	Model sdf.SDF3
 
-const (
-	// MaterialTypeGeneric identifies a generic (default) render material.
-	MaterialTypeGeneric = "generic"
+	// Format overrides the job's DestFormat for this part, if non-empty.
+	Format DestFormat
 
-	// MaterialTypePLA identifies a PLA type render material.
-	MaterialTypePLA = "pla"
-
-	// MaterialTypeABS identifies an ABS type render material.
-	MaterialTypeABS = "abs"
-)
+	// Orientation is a human-readable hint for how the part should sit on the print bed, surfaced
+	// in manifest.json (e.g. "flat side down", "standing on its narrow edge").
+	Orientation string
 
-// Material holds the adjustments for render in a specific material.
-type Material struct {
-	Type      MaterialType
-	Shrinkage float64
+	// SupportsRecommended notes whether this part is expected to need printed supports, surfaced
+	// in manifest.json.
+	SupportsRecommended bool
 }
 
-var (
-	// MaterialGeneric holds the general (default) adjustments for render.
-	MaterialGeneric = Material{
-		Type:      MaterialTypeGeneric,
-		Shrinkage: 1,
-	}
-
-	// MaterialPLA holds the adjustments for render with a generic PLA.
-	MaterialPLA = Material{
-		Type:      MaterialTypePLA,
-		Shrinkage: 1.0 / 0.999, // ~0.1%
-	}
-
-	// MaterialABS holds the adjustments for render with a generic ABS.
-	MaterialABS = Material{
-		Type:      MaterialTypeABS,
-		Shrinkage: 1.0 / 0.995, // ~0.5%
-	}
-)
-
-//#endregion Materials
+//#endregion Settings
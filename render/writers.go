@@ -0,0 +1,177 @@
+package render
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"os"
+)
+
+//#region STL
+
+// writeSTLASCII writes tris out as a plain-text STL, for tooling that can't read the binary form.
+func writeSTLASCII(tris []triangle, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	fmt.Fprintln(w, "solid pterosphera")
+	for _, t := range tris {
+		fmt.Fprintf(w, "  facet normal %g %g %g\n", t.Normal[0], t.Normal[1], t.Normal[2])
+		fmt.Fprintln(w, "    outer loop")
+		for _, v := range t.Vertices {
+			fmt.Fprintf(w, "      vertex %g %g %g\n", v[0], v[1], v[2])
+		}
+		fmt.Fprintln(w, "    endloop")
+		fmt.Fprintln(w, "  endfacet")
+	}
+	fmt.Fprintln(w, "endsolid pterosphera")
+
+	return w.Flush()
+}
+
+//#endregion STL
+
+//#region OBJ
+
+// writeOBJ writes tris out as a Wavefront OBJ, one vertex triplet per facet (undeduplicated - OBJ
+// readers handle the duplication fine, and it keeps this writer a straight pass over tris).
+func writeOBJ(tris []triangle, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	for _, t := range tris {
+		for _, v := range t.Vertices {
+			fmt.Fprintf(w, "v %g %g %g\n", v[0], v[1], v[2])
+		}
+	}
+	for i := range tris {
+		base := i*3 + 1
+		fmt.Fprintf(w, "f %d %d %d\n", base, base+1, base+2)
+	}
+
+	return w.Flush()
+}
+
+//#endregion OBJ
+
+//#region 3MF
+
+// threeMFModel is the minimal subset of the 3MF core spec's 3D/3dmodel.model XML needed to
+// describe a single triangle mesh object.
+type threeMFModel struct {
+	XMLName  xml.Name        `xml:"model"`
+	Xmlns    string          `xml:"xmlns,attr"`
+	Unit     string          `xml:"unit,attr"`
+	Resource threeMFResource `xml:"resources>object"`
+	Item     threeMFItem     `xml:"build>item"`
+}
+
+type threeMFResource struct {
+	ID   string      `xml:"id,attr"`
+	Type string      `xml:"type,attr"`
+	Mesh threeMFMesh `xml:"mesh"`
+}
+
+type threeMFMesh struct {
+	Vertices  []threeMFVertex   `xml:"vertices>vertex"`
+	Triangles []threeMFTriangle `xml:"triangles>triangle"`
+}
+
+type threeMFVertex struct {
+	X float32 `xml:"x,attr"`
+	Y float32 `xml:"y,attr"`
+	Z float32 `xml:"z,attr"`
+}
+
+type threeMFTriangle struct {
+	V1 int `xml:"v1,attr"`
+	V2 int `xml:"v2,attr"`
+	V3 int `xml:"v3,attr"`
+}
+
+type threeMFItem struct {
+	ObjectID string `xml:"objectid,attr"`
+}
+
+// write3MF writes tris out as a minimal 3MF package: a zip archive containing the content-types
+// descriptor, the package relationship pointing at the model part, and the model part itself.
+func write3MF(tris []triangle, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+
+	model := threeMFModel{
+		Xmlns: "http://schemas.microsoft.com/3dmanufacturing/core/2015/02",
+		Unit:  "millimeter",
+		Resource: threeMFResource{
+			ID:   "1",
+			Type: "model",
+		},
+		Item: threeMFItem{ObjectID: "1"},
+	}
+	for i, t := range tris {
+		base := i * 3
+		for _, v := range t.Vertices {
+			model.Resource.Mesh.Vertices = append(model.Resource.Mesh.Vertices, threeMFVertex{X: v[0], Y: v[1], Z: v[2]})
+		}
+		model.Resource.Mesh.Triangles = append(model.Resource.Mesh.Triangles, threeMFTriangle{V1: base, V2: base + 1, V3: base + 2})
+	}
+
+	if err := writeZipXML(zw, "[Content_Types].xml", contentTypesXML); err != nil {
+		return err
+	}
+	if err := writeZipXML(zw, "_rels/.rels", relsXML); err != nil {
+		return err
+	}
+
+	modelW, err := zw.Create("3D/3dmodel.model")
+	if err != nil {
+		return err
+	}
+	if _, err := modelW.Write([]byte(xml.Header)); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(modelW)
+	if err := enc.Encode(model); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func writeZipXML(zw *zip.Writer, name, content string) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write([]byte(content))
+	return err
+}
+
+const contentTypesXML = `<?xml version="1.0" encoding="UTF-8"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+  <Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+  <Default Extension="model" ContentType="application/vnd.ms-package.3dmanufacturing-3dmodel+xml"/>
+</Types>
+`
+
+const relsXML = `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Target="/3D/3dmodel.model" Id="rel0" Type="http://schemas.microsoft.com/3dmanufacturing/2013/01/3dmodel"/>
+</Relationships>
+`
+
+//#endregion 3MF